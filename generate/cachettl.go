@@ -0,0 +1,37 @@
+package generate
+
+// This file handles the cacheTTL option of the @genqlient directive, which
+// sets how long caching clients (see graphql.NewCachingClient) may cache the
+// results of a query.
+
+import (
+	"fmt"
+	"time"
+)
+
+// durationLiteral returns a Go expression for the given duration, in the
+// largest unit in which it's a whole number, e.g. "90 * time.Second".
+func (g *generator) durationLiteral(d time.Duration) (string, error) {
+	unit, unitName := time.Nanosecond, "time.Nanosecond"
+	for _, candidate := range []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+		{time.Microsecond, "time.Microsecond"},
+	} {
+		if d%candidate.unit == 0 {
+			unit, unitName = candidate.unit, candidate.name
+			break
+		}
+	}
+
+	ref, err := g.ref(unitName)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d * %s", d/unit, ref), nil
+}
@@ -0,0 +1,133 @@
+package generate
+
+// This file generates the operation catalog enabled by the emit_catalog
+// option: a JSON description of each operation, its variables, and the shape
+// of its response, for use by documentation tools and API browsers.
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+type catalog struct {
+	Operations []*catalogOperation `json:"operations"`
+}
+
+// catalogOperation describes a single operation in the catalog.
+type catalogOperation struct {
+	Name           string             `json:"name"`
+	Type           ast.Operation      `json:"type"`
+	Description    string             `json:"description,omitempty"`
+	SourceLocation string             `json:"sourceLocation"`
+	Variables      []*catalogVariable `json:"variables"`
+	Response       *catalogType       `json:"response"`
+}
+
+// catalogVariable describes a variable of an operation.
+type catalogVariable struct {
+	Name string `json:"name"`
+	// The GraphQL type, e.g. "[String!]!".
+	Type string `json:"type"`
+	// The Go type of the corresponding argument to the generated function.
+	GoType       string `json:"goType"`
+	DefaultValue string `json:"defaultValue,omitempty"`
+}
+
+// catalogType describes the shape of (part of) a response.
+type catalogType struct {
+	// The Go type, e.g. "[]*GetUsersUsersUser".
+	GoType string `json:"goType"`
+	// The GraphQL named type, e.g. "User".
+	GraphQLType string `json:"graphQLType,omitempty"`
+	// For object types, the fields, in the order they appear in JSON.
+	Fields []*catalogField `json:"fields,omitempty"`
+	// For interface and union types, the shape of each possible concrete
+	// type.
+	Implementations []*catalogType `json:"implementations,omitempty"`
+}
+
+// catalogField describes a field of a response object.
+type catalogField struct {
+	// The name of the field in the response JSON (i.e. its alias, if any).
+	Name string `json:"name"`
+	// The GraphQL field name.
+	GraphQLName string       `json:"graphQLName"`
+	GoName      string       `json:"goName"`
+	Type        *catalogType `json:"type"`
+}
+
+// catalogOperationFor builds the catalog entry for the given operation.
+func catalogOperationFor(
+	op *ast.OperationDefinition,
+	description, sourceFilename string,
+	inputType *goStructType,
+	responseType goType,
+) (*catalogOperation, error) {
+	variables := make([]*catalogVariable, len(op.VariableDefinitions))
+	for i, def := range op.VariableDefinitions {
+		variables[i] = &catalogVariable{
+			Name: def.Variable,
+			Type: def.Type.String(),
+		}
+		if def.DefaultValue != nil {
+			variables[i].DefaultValue = def.DefaultValue.String()
+		}
+		// convertArguments creates the fields in the same order.
+		if inputType != nil && i < len(inputType.Fields) {
+			variables[i].GoType = inputType.Fields[i].GoType.Reference()
+		}
+	}
+
+	response, err := catalogTypeFor(responseType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &catalogOperation{
+		Name:           op.Name,
+		Type:           op.Operation,
+		Description:    description,
+		SourceLocation: sourceFilename,
+		Variables:      variables,
+		Response:       response,
+	}, nil
+}
+
+// catalogTypeFor describes the given response type.
+func catalogTypeFor(typ goType) (*catalogType, error) {
+	retval := &catalogType{
+		GoType:      typ.Reference(),
+		GraphQLType: typ.GraphQLTypeName(),
+	}
+	switch typ := typ.Unwrap().(type) {
+	case *goStructType:
+		// Use the flattened fields, which match the JSON (see
+		// goStructType.FlattenedFields).
+		fields, err := typ.FlattenedFields()
+		if err != nil {
+			return nil, err
+		}
+		retval.Fields = make([]*catalogField, len(fields))
+		for i, field := range fields {
+			fieldType, err := catalogTypeFor(field.GoType)
+			if err != nil {
+				return nil, err
+			}
+			retval.Fields[i] = &catalogField{
+				Name:        field.JSONName,
+				GraphQLName: field.GraphQLName,
+				GoName:      field.GoName,
+				Type:        fieldType,
+			}
+		}
+	case *goInterfaceType:
+		retval.Implementations = make([]*catalogType, len(typ.Implementations))
+		for i, impl := range typ.Implementations {
+			implType, err := catalogTypeFor(impl)
+			if err != nil {
+				return nil, err
+			}
+			retval.Implementations[i] = implType
+		}
+	}
+	return retval, nil
+}
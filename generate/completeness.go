@@ -0,0 +1,133 @@
+package generate
+
+// This file generates the IsComplete methods enabled by the
+// generate_completeness_check option, which check whether a (possibly
+// partial) response has all its non-null fields.  For a type
+//	type GetUserUser struct {
+//		Id      string
+//		Manager *GetUserUserManager // non-null, with pointer: true
+//		Emails  []string            // non-null
+//	}
+// we generate
+//	func (v *GetUserUser) IsComplete() bool {
+//		if v.Manager == nil {
+//			return false
+//		}
+//		if v.Manager != nil {
+//			if !v.Manager.IsComplete() {
+//				return false
+//			}
+//		}
+//		if v.Emails == nil {
+//			return false
+//		}
+//		return true
+//	}
+// and similarly for nested types, whose own IsComplete methods we call.
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const isCompleteSignature = "IsComplete() bool"
+
+// writeIsComplete writes the IsComplete method for the given (response)
+// struct type.
+func (typ *goStructType) writeIsComplete(w io.Writer) error {
+	for _, field := range typ.Fields {
+		if field.GoName == "IsComplete" {
+			return errorf(nil, "generate_completeness_check: %s has a field IsComplete, "+
+				"which conflicts with its IsComplete method; "+
+				"rename it with @genqlient(fieldName: ...)", typ.GoName)
+		}
+	}
+
+	writeDescription(w, fmt.Sprintf(
+		"IsComplete returns whether each non-null field of %s, recursively, "+
+			"is set; see the generate_completeness_check option for details.",
+		typ.GoName))
+	fmt.Fprintf(w, "func (v *%s) %s {\n", typ.GoName, isCompleteSignature)
+	for _, field := range typ.Fields {
+		expr := "v." + field.Selector()
+		if field.IsEmbedded() {
+			// Embedded fields (from fragments) check their own fields.
+			if field.IsOptionalEmbed() {
+				fmt.Fprintf(w, "if %s != nil && !%s.IsComplete() {\nreturn false\n}\n", expr, expr)
+			} else {
+				fmt.Fprintf(w, "if !%s.IsComplete() {\nreturn false\n}\n", expr)
+			}
+			continue
+		}
+		writeIsCompleteValue(w, expr, field.GoType, field.GraphQLType, false, 0)
+	}
+	fmt.Fprintf(w, "return true\n}\n")
+	return nil
+}
+
+// writeIsCompleteValue writes the code to return false if the (addressable)
+// Go expression expr, of the given Go and GraphQL types, is incomplete.
+// present is true if expr is known to be non-null, because it was
+// dereferenced from a non-nil pointer.  depth is used to name loop
+// variables.
+func writeIsCompleteValue(w io.Writer, expr string, goTyp goType, graphQLType *ast.Type, present bool, depth int) {
+	nonNull := graphQLType != nil && graphQLType.NonNull
+	switch goTyp := goTyp.(type) {
+	case *goPointerType:
+		if nonNull {
+			fmt.Fprintf(w, "if %s == nil {\nreturn false\n}\n", expr)
+		}
+		var inner strings.Builder
+		// Structs' methods have pointer receivers, so need no deref.
+		innerExpr := "(*" + expr + ")"
+		if _, ok := goTyp.Elem.(*goStructType); ok {
+			innerExpr = expr
+		}
+		writeIsCompleteValue(&inner, innerExpr, goTyp.Elem, graphQLType, true, depth)
+		if inner.Len() > 0 {
+			fmt.Fprintf(w, "if %s != nil {\n%s}\n", expr, inner.String())
+		}
+
+	case *goSliceType:
+		if nonNull {
+			fmt.Fprintf(w, "if %s == nil {\nreturn false\n}\n", expr)
+		}
+		var elemType *ast.Type
+		if graphQLType != nil {
+			elemType = graphQLType.Elem
+		}
+		i := fmt.Sprintf("i%d", depth)
+		var inner strings.Builder
+		writeIsCompleteValue(&inner, fmt.Sprintf("%s[%s]", expr, i), goTyp.Elem, elemType, false, depth+1)
+		if inner.Len() > 0 {
+			fmt.Fprintf(w, "for %s := range %s {\n%s}\n", i, expr, inner.String())
+		}
+
+	case *goInterfaceType:
+		if nonNull {
+			fmt.Fprintf(w, "if %s == nil || !%s.IsComplete() {\nreturn false\n}\n", expr, expr)
+		} else {
+			fmt.Fprintf(w, "if %s != nil && !%s.IsComplete() {\nreturn false\n}\n", expr, expr)
+		}
+
+	case *goStructType:
+		// A nullable struct which isn't a pointer is the zero value if null,
+		// which we can't tell from a struct which is present but incomplete.
+		if nonNull || present {
+			fmt.Fprintf(w, "if !%s.IsComplete() {\nreturn false\n}\n", expr)
+		}
+
+	case *goEnumType:
+		// The empty string is never a valid enum value.
+		if nonNull || present {
+			fmt.Fprintf(w, "if %s == \"\" {\nreturn false\n}\n", expr)
+		}
+
+	default:
+		// Scalars' zero values are valid values (e.g. 0 or false), and bound
+		// and generic types are opaque to us.
+	}
+}
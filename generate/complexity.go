@@ -0,0 +1,33 @@
+package generate
+
+// This file computes the complexity of operations, for the
+// generate_complexity option.
+
+import "github.com/vektah/gqlparser/v2/ast"
+
+// selectionSetComplexity returns the complexity of the given selection set:
+// each field counts 1, plus the complexity of its own selections.  Fragments
+// are expanded, such that their fields count once per place they're spread.
+// (This is the same as gqlgen's default complexity, except that we count
+// the fields of every fragment, even if they're on different concrete types,
+// so for abstract types the result is an upper bound.)  __typename is free,
+// since genqlient adds it automatically.
+func selectionSetComplexity(selectionSet ast.SelectionSet) int {
+	complexity := 0
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Name == "__typename" {
+				continue
+			}
+			complexity += 1 + selectionSetComplexity(selection.SelectionSet)
+		case *ast.InlineFragment:
+			complexity += selectionSetComplexity(selection.SelectionSet)
+		case *ast.FragmentSpread:
+			if selection.Definition != nil {
+				complexity += selectionSetComplexity(selection.Definition.SelectionSet)
+			}
+		}
+	}
+	return complexity
+}
@@ -0,0 +1,173 @@
+package generate
+
+// This file implements the @genqlient(computed: ...) option, which adds a
+// method computing a value from the other fields of a struct.  For example,
+// given
+//	# @genqlient(computed: "fullName: firstName + ' ' + lastName")
+//	user { firstName lastName }
+// we generate
+//	func (v *GetUserUser) FullName() string { return v.FirstName + " " + v.LastName }
+//
+// The expression language is deliberately minimal: an expression is one or
+// more terms joined by "+", where each term is either the name (or alias) of
+// a selected string-valued field, or a string literal in single or double
+// quotes.
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// computedMethod is a method generated for @genqlient(computed: ...).
+type computedMethod struct {
+	GoName string
+	// The source of the computation, for the doc comment.
+	Source string
+	// The Go expression to return, in terms of the receiver v.
+	Expr string
+}
+
+// convertComputed converts the given computed-option values, of the form
+// "name: expression", to methods on typ.
+func convertComputed(typ *goStructType, specs []string, pos *ast.Position) ([]*computedMethod, error) {
+	fields, err := typ.FlattenedFields()
+	if err != nil {
+		return nil, err
+	}
+	fieldsByName := make(map[string]*selector, len(fields))
+	for _, field := range fields {
+		fieldsByName[field.JSONName] = field
+	}
+
+	methods := make([]*computedMethod, 0, len(specs))
+	for _, spec := range specs {
+		name, expr, ok := strings.Cut(spec, ":")
+		name = strings.TrimSpace(name)
+		if !ok || !graphQLNameRegexp.MatchString(name) {
+			return nil, errorf(pos,
+				`computed must be of the form "name: expression", got %q`, spec)
+		}
+		goName := upperFirst(name)
+		if _, ok := fieldsByName[name]; ok || typ.hasField(goName) ||
+			(strings.HasPrefix(goName, "Get") && typ.hasField(goName[3:])) {
+			return nil, errorf(pos, "computed name %q conflicts with a field of %s",
+				name, typ.GoName)
+		}
+
+		terms, err := splitComputedExpression(expr)
+		if err != nil {
+			return nil, errorf(pos, "invalid computed expression %q: %v", expr, err)
+		}
+		goTerms := make([]string, len(terms))
+		for i, term := range terms {
+			if term[0] == '"' || term[0] == '\'' {
+				goTerms[i] = fmt.Sprintf("%q", term[1:len(term)-1])
+				continue
+			}
+			field, ok := fieldsByName[term]
+			if !ok {
+				return nil, errorf(pos, "computed %q refers to %q, which is not selected here",
+					name, term)
+			}
+			if field.OptionalEmbed != "" {
+				return nil, errorf(pos,
+					"computed %q may not refer to %q, which is in an optional fragment",
+					name, term)
+			}
+			goTerm, ok := computedStringTerm("v."+field.Selector, field.GoType)
+			if !ok {
+				return nil, errorf(pos, "computed %q refers to %q, which is not a string",
+					name, term)
+			}
+			goTerms[i] = goTerm
+		}
+
+		methods = append(methods, &computedMethod{
+			GoName: goName,
+			Source: strings.TrimSpace(expr),
+			Expr:   strings.Join(goTerms, " + "),
+		})
+	}
+	return methods, nil
+}
+
+// hasField returns whether typ has a (non-embedded) field with the given Go
+// name.
+func (typ *goStructType) hasField(goName string) bool {
+	for _, field := range typ.Fields {
+		if field.GoName == goName {
+			return true
+		}
+	}
+	return false
+}
+
+// computedStringTerm returns the Go expression for the string value of ref,
+// of type typ, and whether it has one.
+func computedStringTerm(ref string, typ goType) (string, bool) {
+	switch typ := typ.(type) {
+	case *goOpaqueType:
+		return ref, typ.GoRef == "string"
+	case *goTypenameForBuiltinType:
+		return "string(" + ref + ")", typ.GoBuiltinName == "string"
+	case *goEnumType:
+		return "string(" + ref + ")", true
+	default:
+		return "", false
+	}
+}
+
+// splitComputedExpression splits a computed expression into its terms: field
+// names, and string literals (including their quotes).
+func splitComputedExpression(expr string) ([]string, error) {
+	var terms []string
+	rest := strings.TrimSpace(expr)
+	for {
+		if rest == "" {
+			return nil, fmt.Errorf("expected a field name or string")
+		}
+		var term string
+		switch rest[0] {
+		case '"', '\'':
+			end := strings.IndexByte(rest[1:], rest[0])
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			term = rest[:end+2]
+		default:
+			end := strings.IndexFunc(rest, func(r rune) bool {
+				return r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+			})
+			if end == -1 {
+				end = len(rest)
+			}
+			term = rest[:end]
+			if term == "" {
+				return nil, fmt.Errorf("unexpected %q", rest[0])
+			}
+		}
+		terms = append(terms, term)
+
+		rest = strings.TrimSpace(rest[len(term):])
+		if rest == "" {
+			return terms, nil
+		}
+		if rest[0] != '+' {
+			return nil, fmt.Errorf("expected \"+\", got %q", rest[0])
+		}
+		rest = strings.TrimSpace(rest[1:])
+	}
+}
+
+// writeComputed writes the methods for @genqlient(computed: ...) on typ.
+func (typ *goStructType) writeComputed(w io.Writer) {
+	for _, method := range typ.Computed {
+		writeDescription(w, fmt.Sprintf("%s returns %s.", method.GoName, method.Source))
+		fmt.Fprintf(w, "func (v *%s) %s() string { return %s }\n",
+			typ.GoName, method.GoName, method.Expr)
+	}
+}
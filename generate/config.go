@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"go/token"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"text/template"
 
 	"golang.org/x/tools/go/packages"
 	"gopkg.in/yaml.v2"
@@ -14,6 +17,32 @@ import (
 
 var cfgFilenames = []string{".genqlient.yml", ".genqlient.yaml", "genqlient.yml", "genqlient.yaml"}
 
+// generatedLineRegexp matches the comment which marks a file as generated; see
+// https://pkg.go.dev/cmd/go#hdr-Generate_Go_files_by_processing_source.
+var generatedLineRegexp = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// validateGeneratedHeader checks that the given generated_header (before or
+// after rendering; see Config.GeneratedHeader) is a comment which marks the
+// file as generated.
+func validateGeneratedHeader(header string) error {
+	foundGeneratedLine := false
+	for _, line := range strings.Split(header, "\n") {
+		if !strings.HasPrefix(line, "//") {
+			return errorf(nil, "invalid generated_header in genqlient.yaml: "+
+				"each line must be a // comment, but got %q", line)
+		}
+		if generatedLineRegexp.MatchString(line) {
+			foundGeneratedLine = true
+		}
+	}
+	if !foundGeneratedLine {
+		return errorf(nil, "invalid generated_header in genqlient.yaml: "+
+			"must contain a line matching %v, so that Go tools recognize "+
+			"the file as generated", generatedLineRegexp)
+	}
+	return nil
+}
+
 // Config represents genqlient's configuration, generally read from
 // genqlient.yaml.
 //
@@ -22,20 +51,54 @@ type Config struct {
 	// The following fields are documented in the [genqlient.yaml docs].
 	//
 	// [genqlient.yaml docs]: https://github.com/Khan/genqlient/blob/main/docs/genqlient.yaml
-	Schema              StringList              `yaml:"schema"`
-	Operations          StringList              `yaml:"operations"`
-	Generated           string                  `yaml:"generated"`
-	Package             string                  `yaml:"package"`
-	ExportOperations    string                  `yaml:"export_operations"`
-	ContextType         string                  `yaml:"context_type"`
-	ClientGetter        string                  `yaml:"client_getter"`
-	Bindings            map[string]*TypeBinding `yaml:"bindings"`
-	PackageBindings     []*PackageBinding       `yaml:"package_bindings"`
-	Casing              Casing                  `yaml:"casing"`
-	Optional            string                  `yaml:"optional"`
-	OptionalGenericType string                  `yaml:"optional_generic_type"`
-	StructReferences    bool                    `yaml:"use_struct_references"`
-	Extensions          bool                    `yaml:"use_extensions"`
+	Schema                     StringList              `yaml:"schema"`
+	Operations                 StringList              `yaml:"operations"`
+	OperationFunctions         StringList              `yaml:"operation_functions"`
+	Generated                  string                  `yaml:"generated"`
+	Package                    string                  `yaml:"package"`
+	ExportOperations           string                  `yaml:"export_operations"`
+	FragmentsPackage           string                  `yaml:"fragments_package"`
+	EmitCatalog                string                  `yaml:"emit_catalog"`
+	EmitVariablesJSONSchema    string                  `yaml:"emit_variables_jsonschema"`
+	EmitProto                  string                  `yaml:"emit_proto"`
+	ProtoGoPackage             string                  `yaml:"proto_go_package"`
+	ProtoScalars               map[string]string       `yaml:"proto_scalars"`
+	ContextType                string                  `yaml:"context_type"`
+	ContextAdapter             string                  `yaml:"context_adapter"`
+	ContextPosition            string                  `yaml:"context_position"`
+	ClientGetter               string                  `yaml:"client_getter"`
+	Bindings                   map[string]*TypeBinding `yaml:"bindings"`
+	PackageBindings            []*PackageBinding       `yaml:"package_bindings"`
+	Casing                     Casing                  `yaml:"casing"`
+	Optional                   string                  `yaml:"optional"`
+	OptionalGenericType        string                  `yaml:"optional_generic_type"`
+	StructReferences           bool                    `yaml:"use_struct_references"`
+	Extensions                 bool                    `yaml:"use_extensions"`
+	OptionalConditionalFields  bool                    `yaml:"use_optional_for_conditional_fields"`
+	ResponseType               string                  `yaml:"response_type"`
+	GenerateFieldPaths         bool                    `yaml:"generate_field_paths"`
+	OperationOptions           bool                    `yaml:"operation_options"`
+	GeneratedHeader            string                  `yaml:"generated_header"`
+	CopyDescriptions           bool                    `yaml:"copy_descriptions"`
+	InputFieldOrder            string                  `yaml:"input_field_order"`
+	TypenameStrategy           string                  `yaml:"typename_strategy"`
+	GenerateInputBuilders      bool                    `yaml:"generate_input_builders"`
+	GenerateInputMerge         bool                    `yaml:"generate_input_merge"`
+	GenerateOneOfConstructors  bool                    `yaml:"generate_oneof_constructors"`
+	GenerateValidation         bool                    `yaml:"generate_validation"`
+	GenerateTextMarshalers     bool                    `yaml:"generate_text_marshalers"`
+	GenerateFragmentInterfaces bool                    `yaml:"generate_fragment_interfaces"`
+	GenerateEnumValueLists     bool                    `yaml:"generate_enum_value_lists"`
+	GenerateComplexity         bool                    `yaml:"generate_complexity"`
+	GenerateWalk               bool                    `yaml:"generate_walk"`
+	GenerateCompletenessCheck  bool                    `yaml:"generate_completeness_check"`
+	GenerateFakes              bool                    `yaml:"generate_fakes"`
+	GenerateUploadListers      bool                    `yaml:"generate_upload_listers"`
+	GenerateRepresentations    bool                    `yaml:"generate_representations"`
+	GenerateRegistry           bool                    `yaml:"generate_registry"`
+	ExportPolicy               ExportPolicy            `yaml:"export_policy"`
+	TypesOnly                  bool                    `yaml:"types_only"`
+	PostGenerateHooks          StringList              `yaml:"post_generate_hooks"`
 
 	// Set to true to use features that aren't fully ready to use.
 	//
@@ -44,6 +107,12 @@ type Config struct {
 	// them at your own risk!
 	AllowBrokenFeatures bool `yaml:"allow_broken_features"`
 
+	// Custom code generation steps, which [Generate] runs after its own
+	// (which is itself the first plugin it runs); see [Plugin].  Plugins can't be configured in genqlient.yaml; to use them,
+	// read the config (e.g. with [ReadAndValidateConfig]), set this field,
+	// and call [Generate].
+	Plugins []Plugin `yaml:"-"`
+
 	// The directory of the config-file (relative to which all the other paths
 	// are resolved).  Set by ValidateAndFillDefaults.
 	baseDir string
@@ -60,6 +129,24 @@ type TypeBinding struct {
 	ExpectExactFields string `yaml:"expect_exact_fields"`
 	Marshaler         string `yaml:"marshaler"`
 	Unmarshaler       string `yaml:"unmarshaler"`
+	Stringer          string `yaml:"stringer"`
+	TimeLayout        string `yaml:"time_layout"`
+	SkipNull          bool   `yaml:"skip_null"`
+}
+
+func (binding *TypeBinding) validate(name string) error {
+	if binding.TimeLayout == "" {
+		return nil
+	}
+	if binding.Type == "" {
+		binding.Type = "time.Time"
+	} else if binding.Type != "time.Time" {
+		return errorf(nil, "binding for %s: time_layout may only be used with type time.Time", name)
+	}
+	if binding.Marshaler != "" || binding.Unmarshaler != "" {
+		return errorf(nil, "binding for %s: time_layout may not be used with marshaler or unmarshaler", name)
+	}
+	return nil
 }
 
 // A PackageBinding represents a Go package for which genqlient will
@@ -96,8 +183,10 @@ func (algo CasingAlgorithm) validate() error {
 //
 // [genqlient.yaml docs]: https://github.com/Khan/genqlient/blob/main/docs/genqlient.yaml
 type Casing struct {
-	AllEnums CasingAlgorithm            `yaml:"all_enums"`
-	Enums    map[string]CasingAlgorithm `yaml:"enums"`
+	AllEnums           CasingAlgorithm            `yaml:"all_enums"`
+	Enums              map[string]CasingAlgorithm `yaml:"enums"`
+	Initialisms        []string                   `yaml:"initialisms"`
+	FieldNameOverrides map[string]string          `yaml:"field_name_overrides"`
 }
 
 func (casing *Casing) validate() error {
@@ -106,14 +195,55 @@ func (casing *Casing) validate() error {
 			return err
 		}
 	}
-	for _, algo := range casing.Enums {
-		if err := algo.validate(); err != nil {
+	for _, name := range sortedKeys(casing.Enums) {
+		if err := casing.Enums[name].validate(); err != nil {
 			return err
 		}
 	}
+	for _, initialism := range casing.Initialisms {
+		if !token.IsIdentifier(initialism) || strings.Contains(initialism, "_") {
+			return errorf(nil, "invalid initialism %q: must be letters and digits", initialism)
+		}
+	}
+	for _, name := range sortedKeys(casing.FieldNameOverrides) {
+		goName := casing.FieldNameOverrides[name]
+		if !token.IsIdentifier(goName) || !token.IsExported(goName) {
+			return errorf(nil,
+				"invalid field_name_overrides for %s: %q is not an exported Go identifier",
+				name, goName)
+		}
+	}
 	return nil
 }
 
+// fieldName returns the Go name for the struct-field corresponding to the
+// GraphQL field (or alias, or variable) name, which belongs to the GraphQL
+// type typeName (empty for variables).
+func (casing *Casing) fieldName(typeName, name string) string {
+	if goName, ok := casing.FieldNameOverrides[typeName+"."+name]; ok && typeName != "" {
+		return goName
+	}
+	if goName, ok := casing.FieldNameOverrides[name]; ok {
+		return goName
+	}
+
+	goName := upperFirst(name)
+	if len(casing.Initialisms) == 0 {
+		return goName
+	}
+	initialisms := make(map[string]bool, len(casing.Initialisms))
+	for _, initialism := range casing.Initialisms {
+		initialisms[strings.ToUpper(initialism)] = true
+	}
+	words := splitWords(goName)
+	for i, word := range words {
+		if upper := strings.ToUpper(word); initialisms[upper] {
+			words[i] = upper
+		}
+	}
+	return strings.Join(words, "")
+}
+
 func (casing *Casing) forEnum(graphQLTypeName string) CasingAlgorithm {
 	if specificConfig, ok := casing.Enums[graphQLTypeName]; ok {
 		return specificConfig
@@ -124,6 +254,49 @@ func (casing *Casing) forEnum(graphQLTypeName string) CasingAlgorithm {
 	return CasingDefault
 }
 
+// ExportPolicy controls which generated types and functions are exported,
+// and is documented further in the [genqlient.yaml docs].
+//
+// [genqlient.yaml docs]: https://github.com/Khan/genqlient/blob/main/docs/genqlient.yaml
+type ExportPolicy struct {
+	Operations  string `yaml:"operations"`
+	Fragments   string `yaml:"fragments"`
+	SchemaTypes string `yaml:"schema_types"`
+}
+
+func (policy *ExportPolicy) validate(fragmentsPackage string) error {
+	for _, setting := range []struct{ name, value string }{
+		{"operations", policy.Operations},
+		{"fragments", policy.Fragments},
+		{"schema_types", policy.SchemaTypes},
+	} {
+		if setting.value != "" && setting.value != "exported" && setting.value != "unexported" {
+			return errorf(nil, "export_policy.%s must be one of: 'exported' or 'unexported'",
+				setting.name)
+		}
+	}
+	if fragmentsPackage != "" && (policy.Fragments == "unexported" || policy.SchemaTypes == "unexported") {
+		return errorf(nil, "export_policy may not make fragments or schema types "+
+			"unexported if fragments_package is set, since they must be "+
+			"referenced from another package")
+	}
+	return nil
+}
+
+// exportedName returns name, exported or unexported according to the given
+// export_policy setting: "exported", "unexported", or empty to leave it as
+// is.
+func exportedName(setting, name string) string {
+	switch setting {
+	case "exported":
+		return upperFirst(name)
+	case "unexported":
+		return lowerFirst(name)
+	default:
+		return name
+	}
+}
+
 // pathJoin is like filepath.Join but 1) it only takes two argsuments,
 // and b) if the second argument is an absolute path the first argument
 // is ignored (similar to how python's os.path.join() works).
@@ -200,21 +373,88 @@ func (c *Config) ValidateAndFillDefaults(baseDir string) error {
 	if c.ExportOperations != "" {
 		c.ExportOperations = pathJoin(baseDir, c.ExportOperations)
 	}
+	if c.EmitCatalog != "" {
+		c.EmitCatalog = pathJoin(baseDir, c.EmitCatalog)
+	}
+	if c.EmitVariablesJSONSchema != "" {
+		c.EmitVariablesJSONSchema = pathJoin(baseDir, c.EmitVariablesJSONSchema)
+	}
+	if c.EmitProto != "" {
+		c.EmitProto = pathJoin(baseDir, c.EmitProto)
+	}
+
+	for _, hook := range c.PostGenerateHooks {
+		if len(strings.Fields(hook)) == 0 {
+			return errorf(nil, "post_generate_hooks may not contain empty commands")
+		}
+	}
 
 	if c.ContextType == "" {
 		c.ContextType = "context.Context"
 	}
 
+	if c.ContextAdapter != "" && c.ContextType == "-" {
+		return errorf(nil, "context_adapter may not be used if context_type is '-'")
+	}
+
+	if c.ResponseType != "" && c.Extensions {
+		return errorf(nil, "response_type may not be used with use_extensions; "+
+			"use the Extensions field of the response type instead")
+	}
+
+	if c.ContextPosition == "" {
+		c.ContextPosition = "first"
+	} else if c.ContextPosition != "first" && c.ContextPosition != "last" {
+		return errorf(nil, "context_position must be one of: 'first' (default) or 'last'")
+	}
+
 	if c.Optional != "" && c.Optional != "value" && c.Optional != "pointer" && c.Optional != "generic" {
 		return errorf(nil, "optional must be one of: 'value' (default), 'pointer', or 'generic'")
 	}
 
+	if c.InputFieldOrder != "" && c.InputFieldOrder != "schema" && c.InputFieldOrder != "alpha" {
+		return errorf(nil, "input_field_order must be one of: 'schema' (default) or 'alpha'")
+	}
+
+	if c.TypenameStrategy != "" && c.TypenameStrategy != "abstract-only" && c.TypenameStrategy != "always" {
+		return errorf(nil, "typename_strategy must be one of: 'abstract-only' (default) or 'always'")
+	}
+
+	if c.ProtoGoPackage != "" {
+		if c.EmitProto == "" {
+			return errorf(nil, "proto_go_package requires emit_proto")
+		}
+		if !token.IsIdentifier(path.Base(c.ProtoGoPackage)) {
+			return errorf(nil, "invalid proto_go_package in genqlient.yaml: "+
+				"the last element of the import path, '%v', is not a valid identifier",
+				path.Base(c.ProtoGoPackage))
+		}
+	}
+	for _, name := range sortedKeys(c.ProtoScalars) {
+		if _, ok := protoScalarGoTypes[c.ProtoScalars[name]]; !ok {
+			return errorf(nil, "invalid proto_scalars entry for %s in genqlient.yaml: "+
+				"'%v' is not a protobuf scalar type", name, c.ProtoScalars[name])
+		}
+	}
+
 	if c.Optional == "generic" && c.OptionalGenericType == "" {
 		return errorf(nil, "if optional is set to 'generic', optional_generic_type must be set to the fully"+
 			"qualified name of a type with a single generic parameter"+
 			"\nExample: \"github.com/Org/Repo/optional.Value\"")
 	}
 
+	if c.GeneratedHeader != "" {
+		// We check the template itself here, so as to report errors early;
+		// generatedHeader checks it again once rendered.
+		_, err := template.New("generated_header").Parse(c.GeneratedHeader)
+		if err != nil {
+			return errorf(nil, "invalid generated_header in genqlient.yaml: %v", err)
+		}
+		if err := validateGeneratedHeader(strings.TrimRight(c.GeneratedHeader, "\n")); err != nil {
+			return err
+		}
+	}
+
 	if c.Package != "" && !token.IsIdentifier(c.Package) {
 		// No need for link here -- if you're already setting the package
 		// you know where to set the package.
@@ -250,6 +490,12 @@ func (c *Config) ValidateAndFillDefaults(baseDir string) error {
 	// This is a no-op in some of the error cases, but it still doesn't hurt.
 	c.pkgPath = pkgPath
 
+	for _, name := range sortedKeys(c.Bindings) {
+		if err := c.Bindings[name].validate(name); err != nil {
+			return err
+		}
+	}
+
 	if len(c.PackageBindings) > 0 {
 		for _, binding := range c.PackageBindings {
 			if strings.HasSuffix(binding.Package, ".go") {
@@ -303,6 +549,10 @@ func (c *Config) ValidateAndFillDefaults(baseDir string) error {
 		return err
 	}
 
+	if err := c.ExportPolicy.validate(c.FragmentsPackage); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -12,6 +12,7 @@ package generate
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/vektah/gqlparser/v2/ast"
 )
@@ -71,6 +72,25 @@ func (g *generator) addType(typ goType, goName string, pos *ast.Position) (goTyp
 	return typ, nil
 }
 
+// addStringerType adds the wrapper type which implements fmt.Stringer for
+// the given bound type, as configured by TypeBinding.Stringer.
+func (g *generator) addStringerType(graphQLName, goRef, stringer string, pos *ast.Position) error {
+	typ := &goStringerType{
+		GoName:      upperFirst(graphQLName) + "Stringer",
+		GraphQLName: graphQLName,
+		BoundRef:    goRef,
+	}
+	if stringer != "method" {
+		var err error
+		typ.StringerRef, err = g.ref(stringer)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := g.addType(typ, typ.GoName, pos)
+	return err
+}
+
 // baseTypeForOperation returns the definition of the GraphQL type to which the
 // root of the operation corresponds, e.g. the "Query" or "Mutation" type.
 func (g *generator) baseTypeForOperation(operation ast.Operation) (*ast.Definition, error) {
@@ -95,8 +115,9 @@ func (g *generator) convertOperation(
 	operation *ast.OperationDefinition,
 	queryOptions *genqlientDirective,
 ) (goType, error) {
-	name := operation.Name + "Response"
-	namePrefix := newPrefixList(operation.Name)
+	goName := g.operationGoName(operation)
+	name := goName + "Response"
+	namePrefix := newPrefixList(goName)
 	if queryOptions.TypeName != "" {
 		name = queryOptions.TypeName
 		namePrefix = newPrefixList(queryOptions.TypeName)
@@ -107,6 +128,13 @@ func (g *generator) convertOperation(
 		return nil, errorf(operation.Position, "%v", err)
 	}
 
+	// Like subscriptions, live queries (which the server re-executes, sending
+	// a new response whenever the result changes) need a stream of responses,
+	// which the generated functions can't yet return.
+	if operation.Directives.ForName("live") != nil {
+		return nil, errorf(operation.Position, "genqlient does not yet support @live queries")
+	}
+
 	// Instead of calling out to convertType/convertDefinition, we do our own
 	// thing, because we want to do a few things differently, and because we
 	// know we have an object type, so we can include only that case.
@@ -129,7 +157,7 @@ func (g *generator) convertOperation(
 		GoName: name,
 		descriptionInfo: descriptionInfo{
 			CommentOverride: fmt.Sprintf(
-				"%v is returned by %v on success.", name, operation.Name),
+				"%v is returned by %v on success.", name, goName),
 			GraphQLName: baseType.Name,
 			// omit the GraphQL description for baseType; it's uninteresting.
 		},
@@ -162,7 +190,7 @@ func (g *generator) convertArguments(
 	if len(operation.VariableDefinitions) == 0 {
 		return nil, nil
 	}
-	name := "__" + operation.Name + "Input"
+	name := "__" + g.operationGoName(operation) + "Input"
 	fields := make([]*goStructField, len(operation.VariableDefinitions))
 	for i, arg := range operation.VariableDefinitions {
 		if goKeywords[arg.Variable] {
@@ -174,7 +202,7 @@ func (g *generator) convertArguments(
 			return nil, err
 		}
 
-		goName := upperFirst(arg.Variable)
+		goName := g.Config.Casing.fieldName("", arg.Variable)
 		// Some of the arguments don't apply here, namely the name-prefix (see
 		// names.go) and the selection-set (we use all the input type's fields,
 		// and so on recursively).  See also the `case ast.InputObject` in
@@ -184,14 +212,25 @@ func (g *generator) convertArguments(
 			return nil, err
 		}
 
+		if options.JSONTag != "" {
+			err = validateJSONTag(options.JSONTag, arg.Variable, options.pos)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		fields[i] = &goStructField{
 			GoName:      goName,
 			GoType:      goTyp,
 			JSONName:    arg.Variable,
 			GraphQLName: arg.Variable,
 			Omitempty:   options.GetOmitempty(),
+			JSONTag:     options.JSONTag,
 		}
 	}
+	if err := checkGoNameConflicts(operation.Name, fields); err != nil {
+		return nil, err
+	}
 	goTyp := &goStructType{
 		GoName:    name,
 		Fields:    fields,
@@ -238,10 +277,26 @@ func (g *generator) convertType(
 	}
 
 	if typ.Elem != nil {
-		// Type is a list.
+		// Type is a list.  slicePointer applies only to the outermost list,
+		// so we omit it when converting the elements (but keep any omitempty
+		// they set, see below).
+		elemOptions := options
+		if options.GetSlicePointer() {
+			elemOptions = new(genqlientDirective)
+			*elemOptions = *options
+			elemOptions.SlicePointer = nil
+		}
 		elem, err := g.convertType(
-			namePrefix, typ.Elem, selectionSet, options, queryOptions)
-		return &goSliceType{elem}, err
+			namePrefix, typ.Elem, selectionSet, elemOptions, queryOptions)
+		options.Omitempty = elemOptions.Omitempty
+
+		var goTyp goType = &goSliceType{elem}
+		if options.GetSlicePointer() {
+			// A *[]T, so that callers can distinguish a nil slice from an
+			// empty one even when omitempty is set.
+			goTyp = &goPointerType{goTyp}
+		}
+		return goTyp, err
 	}
 
 	// If this is a builtin type or custom scalar, just refer to it.
@@ -263,7 +318,8 @@ func (g *generator) convertType(
 	} else if !options.PointerIsFalse() && (options.GetPointer() || (!typ.NonNull && g.Config.Optional == "pointer")) {
 		// Whatever we get, wrap it in a pointer.  (Because of the way the
 		// options work, recursing here isn't as connvenient.)
-		// Note this does []*T or [][]*T, not e.g. *[][]T.  See #16.
+		// Note this does []*T or [][]*T, not e.g. *[][]T; for that, use
+		// slicePointer.  See #16.
 		goTyp = &goPointerType{goTyp}
 	} else if !typ.NonNull && g.Config.Optional == "generic" {
 		var genericRef string
@@ -318,6 +374,13 @@ func (g *generator) convertDefinition(
 			Type: "github.com/Khan/genqlient/graphql.Upload",
 		}
 	}
+	// Similarly, the federation scalar _Any defaults to graphql.Representation.
+	if def.Kind == ast.Scalar && def.Name == "_Any" {
+		hasBinding = true
+		globalBinding = &TypeBinding{
+			Type: "github.com/Khan/genqlient/graphql.Representation",
+		}
+	}
 	// Override if there is user binding
 	if binding, ok := g.Config.Bindings[def.Name]; ok {
 		hasBinding = true
@@ -338,11 +401,30 @@ func (g *generator) convertDefinition(
 			}
 		}
 		goRef, err := g.ref(globalBinding.Type)
+		if err == nil && globalBinding.Stringer != "" {
+			err = g.addStringerType(def.Name, goRef, globalBinding.Stringer, pos)
+		}
+		if globalBinding.TimeLayout != "" {
+			g.timeLayouts[def.Name] = globalBinding.TimeLayout
+			return &goOpaqueType{
+				GoRef:           goRef,
+				GraphQLName:     def.Name,
+				Marshaler:       timeMarshalerName(def.Name),
+				Unmarshaler:     timeUnmarshalerName(def.Name),
+				LocalMarshalers: true,
+			}, err
+		}
+		unmarshaler := globalBinding.Unmarshaler
+		if unmarshaler == "" && globalBinding.SkipNull {
+			// Unmarshaling via the custom-unmarshaler path skips nulls; see
+			// unmarshal.go.tmpl.
+			unmarshaler = "encoding/json.Unmarshal"
+		}
 		return &goOpaqueType{
 			GoRef:       goRef,
 			GraphQLName: def.Name,
 			Marshaler:   globalBinding.Marshaler,
-			Unmarshaler: globalBinding.Unmarshaler,
+			Unmarshaler: unmarshaler,
 		}, err
 	}
 	goBuiltinName, ok := builtinTypes[def.Name]
@@ -373,11 +455,23 @@ func (g *generator) convertDefinition(
 		// qualifiers.  This is especially helpful because the caller is very
 		// likely to need to reference these types in their code.
 		name = upperFirst(def.Name)
+		if g.Config.ExportPolicy.SchemaTypes == "unexported" {
+			name = lowerFirst(def.Name)
+		}
 		// (namePrefix is ignored in this case.)
 	} else {
 		// Else, construct a name using the usual algorithm (see names.go).
 		name = makeTypeName(namePrefix, def.Name)
 	}
+	if g.inSharedFragment {
+		// All the types used by a shared fragment, including enums, are
+		// generated in the fragments_package, so that's where we refer to.
+		var err error
+		name, err = g.ref(g.Config.FragmentsPackage + "." + name)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// If we already generated the type, we can skip it as long as it matches
 	// (and must fail if it doesn't).  (This can happen for input/enum types,
@@ -427,6 +521,12 @@ func (g *generator) convertDefinition(
 			descriptionInfo: desc,
 			Generator:       g,
 		}
+		if len(options.Computed) > 0 {
+			goType.Computed, err = convertComputed(goType, options.Computed, options.pos)
+			if err != nil {
+				return nil, err
+			}
+		}
 		return g.addType(goType, goType.GoName, pos)
 
 	case ast.InputObject:
@@ -435,6 +535,7 @@ func (g *generator) convertDefinition(
 			Fields:          make([]*goStructField, len(def.Fields)),
 			descriptionInfo: desc,
 			IsInput:         true,
+			OneOf:           def.Directives.ForName("oneOf") != nil,
 			Generator:       g,
 		}
 		// To handle recursive types, we need to add the type to the type-map
@@ -451,7 +552,7 @@ func (g *generator) convertDefinition(
 				return nil, err
 			}
 
-			goName := upperFirst(field.Name)
+			goName := g.Config.Casing.fieldName(def.Name, field.Name)
 			// Several of the arguments don't really make sense here:
 			// (note field.Type is necessarily a scalar, input, or enum)
 			//  - namePrefix is ignored for input types and enums (see
@@ -487,6 +588,13 @@ func (g *generator) convertDefinition(
 				}
 			}
 
+			if fieldOptions.JSONTag != "" {
+				err = validateJSONTag(fieldOptions.JSONTag, field.Name, fieldOptions.pos)
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			goType.Fields[i] = &goStructField{
 				GoName:      goName,
 				GoType:      fieldGoType,
@@ -494,8 +602,18 @@ func (g *generator) convertDefinition(
 				GraphQLName: field.Name,
 				Description: field.Description,
 				Omitempty:   fieldOptions.GetOmitempty(),
+				JSONTag:     fieldOptions.JSONTag,
+				GraphQLType: field.Type,
 			}
 		}
+		if err := checkGoNameConflicts(def.Name, goType.Fields); err != nil {
+			return nil, err
+		}
+		if g.Config.InputFieldOrder == "alpha" {
+			sort.SliceStable(goType.Fields, func(i, j int) bool {
+				return goType.Fields[i].GraphQLName < goType.Fields[j].GraphQLName
+			})
+		}
 		return goType, nil
 
 	case ast.Interface, ast.Union:
@@ -628,13 +746,20 @@ func (g *generator) convertSelectionSet(
 			}
 			fields = append(fields, field)
 		case *ast.FragmentSpread:
-			maybeField, err := g.convertFragmentSpread(selection, containingTypedef)
+			if err := checkNotDeferred(selection.Directives, selection.Position); err != nil {
+				return nil, err
+			}
+			maybeField, err := g.convertFragmentSpread(
+				selection, containingTypedef, selectionOptions)
 			if err != nil {
 				return nil, err
 			} else if maybeField != nil {
 				fields = append(fields, maybeField)
 			}
 		case *ast.InlineFragment:
+			if err := checkNotDeferred(selection.Directives, selection.Position); err != nil {
+				return nil, err
+			}
 			// (Note this will return nil, nil if the fragment doesn't apply to
 			// this type.)
 			fragmentFields, err := g.convertInlineFragment(
@@ -652,7 +777,7 @@ func (g *generator) convertSelectionSet(
 	//	{ id, id, id, ... on SubType { id } }
 	// (which, yes, is legal) we'll treat that as just { id }.
 	uniqFields := make([]*goStructField, 0, len(selectionSet))
-	fragmentNames := make(map[string]bool, len(selectionSet))
+	fragmentNames := make(map[string]*goStructField, len(selectionSet))
 	fieldNames := make(map[string]bool, len(selectionSet))
 	for _, field := range fields {
 		// If you embed a field twice via a named fragment, we keep both, even
@@ -664,12 +789,17 @@ func (g *generator) convertSelectionSet(
 		//	{ ...MyFragment, ... on SubType { ...MyFragment } }
 		// we'll still deduplicate that.
 		if field.JSONName == "" {
-			name := field.GoType.Reference()
-			if fragmentNames[name] {
+			name := field.GoType.Unwrap().Reference()
+			if other, ok := fragmentNames[name]; ok {
+				if other.IsOptionalEmbed() != field.IsOptionalEmbed() {
+					return nil, errorf(nil,
+						"fragment %s is spread both with and without optionalFragment "+
+							"in the same selection of %s", name, containingTypedef.Name)
+				}
 				continue
 			}
 			uniqFields = append(uniqFields, field)
-			fragmentNames[name] = true
+			fragmentNames[name] = field
 			continue
 		}
 
@@ -704,9 +834,51 @@ func (g *generator) convertSelectionSet(
 		uniqFields = append(uniqFields, field)
 		fieldNames[field.JSONName] = true
 	}
+	if err := checkGoNameConflicts(containingTypedef.Name, uniqFields); err != nil {
+		return nil, err
+	}
+
+	for _, field := range uniqFields {
+		if !field.IsOptionalEmbed() {
+			continue
+		}
+		keys, err := optionalFragmentKeys(field, uniqFields)
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) == 0 {
+			// We'd have no way to tell whether the fragment was included.
+			return nil, errorf(nil,
+				"optional fragment %s must select some field not otherwise "+
+					"selected in the same selection of %s",
+				field.Selector(), containingTypedef.Name)
+		}
+		field.PresenceKeys = keys
+	}
 	return uniqFields, nil
 }
 
+// checkGoNameConflicts returns an error if two of the given fields of the
+// GraphQL type typeName have the same Go name, as can happen if the casing
+// options (see Casing) map two different GraphQL names to the same Go name.
+func checkGoNameConflicts(typeName string, fields []*goStructField) error {
+	jsonNames := make(map[string]string, len(fields))
+	for _, field := range fields {
+		if field.IsEmbedded() {
+			continue
+		}
+		if other, ok := jsonNames[field.GoName]; ok && other != field.JSONName {
+			return errorf(nil,
+				"fields %s.%s and %s.%s have the same Go name %s; "+
+					"use casing.field_name_overrides in genqlient.yaml or "+
+					"@genqlient(fieldName: ...) to rename one",
+				typeName, other, typeName, field.JSONName, field.GoName)
+		}
+		jsonNames[field.GoName] = field.JSONName
+	}
+	return nil
+}
+
 // fragmentMatches returns true if the given fragment is "active" when applied
 // to the given type.
 //
@@ -752,6 +924,18 @@ func fragmentMatches(containingTypedef, fragmentTypedef *ast.Definition) bool {
 	return false
 }
 
+// checkNotDeferred returns an error if the fragment (spread or inline) with
+// the given directives is marked @defer.  Like @live queries, deferred
+// fragments (which the server sends in later payloads of an incremental
+// response) need a stream of responses, which the generated functions can't
+// yet return.
+func checkNotDeferred(directives ast.DirectiveList, pos *ast.Position) error {
+	if directives.ForName("defer") != nil {
+		return errorf(pos, "genqlient does not yet support @defer")
+	}
+	return nil
+}
+
 // convertInlineFragment converts a single GraphQL inline fragment
 // (`... on MyType { myField }`) into Go struct-fields.
 //
@@ -788,16 +972,20 @@ func (g *generator) convertInlineFragment(
 func (g *generator) convertFragmentSpread(
 	fragmentSpread *ast.FragmentSpread,
 	containingTypedef *ast.Definition,
+	options *genqlientDirective,
 ) (*goStructField, error) {
 	if !fragmentMatches(containingTypedef, fragmentSpread.Definition.Definition) {
 		return nil, nil
 	}
 
-	typ, ok := g.typeMap[fragmentSpread.Name]
+	name, err := g.fragmentTypeName(fragmentSpread.Name)
+	if err != nil {
+		return nil, err
+	}
+	typ, ok := g.typeMap[name]
 	if !ok {
 		// If we haven't yet, convert the fragment itself.  Note that fragments
 		// aren't allowed to have cycles, so this won't recurse forever.
-		var err error
 		typ, err = g.convertNamedFragment(fragmentSpread.Definition)
 		if err != nil {
 			return nil, err
@@ -825,13 +1013,35 @@ func (g *generator) convertFragmentSpread(
 		}
 	}
 
-	// TODO(benkraft): Set directive here if we ever allow @genqlient
-	// directives on fragment-spreads.
+	if options.OptionalFragment != "" {
+		if !isOptionalFragment(fragmentSpread) {
+			// addOptionalFragments only rewrites spreads in operations.
+			return nil, errorf(fragmentSpread.Position,
+				"optionalFragment may only be used in operations, not in "+
+					"fragment definitions")
+		}
+		typ = &goPointerType{typ}
+	}
+
 	return &goStructField{GoName: "" /* i.e. embedded */, GoType: typ}, nil
 }
 
+// fragmentTypeName returns the Go name of the type for the named fragment
+// with the given name.  If fragments_package is set, this is qualified with
+// the package in which the fragment's types are generated.
+func (g *generator) fragmentTypeName(fragmentName string) (string, error) {
+	fragmentName = exportedName(g.Config.ExportPolicy.Fragments, fragmentName)
+	if g.Config.FragmentsPackage == "" {
+		return fragmentName, nil
+	}
+	return g.ref(g.Config.FragmentsPackage + "." + fragmentName)
+}
+
 // convertNamedFragment converts a single GraphQL named fragment-definition
 // (`fragment MyFragment on MyType { ... }`) into a Go struct.
+//
+// If fragments_package is set, the types are still converted, since we need
+// to know their fields, but they refer to (and are written to) that package.
 func (g *generator) convertNamedFragment(fragment *ast.FragmentDefinition) (goType, error) {
 	typ := g.schema.Types[fragment.TypeCondition]
 
@@ -840,6 +1050,15 @@ func (g *generator) convertNamedFragment(fragment *ast.FragmentDefinition) (goTy
 		return nil, err
 	}
 
+	name, err := g.fragmentTypeName(fragment.Name)
+	if err != nil {
+		return nil, err
+	}
+	if g.Config.FragmentsPackage != "" {
+		defer func(prev bool) { g.inSharedFragment = prev }(g.inSharedFragment)
+		g.inSharedFragment = true
+	}
+
 	desc := descriptionInfo{
 		CommentOverride:    comment,
 		GraphQLName:        typ.Name,
@@ -851,7 +1070,8 @@ func (g *generator) convertNamedFragment(fragment *ast.FragmentDefinition) (goTy
 	// things like type-names are a bit different.
 
 	fields, err := g.convertSelectionSet(
-		newPrefixList(fragment.Name), fragment.SelectionSet, typ, directive)
+		newPrefixList(exportedName(g.Config.ExportPolicy.Fragments, fragment.Name)),
+		fragment.SelectionSet, typ, directive)
 	if err != nil {
 		return nil, err
 	}
@@ -868,30 +1088,38 @@ func (g *generator) convertNamedFragment(fragment *ast.FragmentDefinition) (goTy
 	switch typ.Kind {
 	case ast.Object:
 		goType := &goStructType{
-			GoName:          fragment.Name,
-			Fields:          fields,
-			Selection:       fragment.SelectionSet,
-			descriptionInfo: desc,
-			Generator:       g,
+			GoName:            name,
+			Fields:            fields,
+			Selection:         fragment.SelectionSet,
+			FragmentInterface: g.Config.GenerateFragmentInterfaces,
+			descriptionInfo:   desc,
+			Generator:         g,
 		}
-		g.typeMap[fragment.Name] = goType
+		if len(directive.Computed) > 0 {
+			goType.Computed, err = convertComputed(goType, directive.Computed, directive.pos)
+			if err != nil {
+				return nil, err
+			}
+		}
+		g.typeMap[name] = goType
 		return goType, nil
 	case ast.Interface, ast.Union:
 		implementationTypes := g.schema.GetPossibleTypes(typ)
 		// Make sure we generate stable output by sorting the types by name when we get them
 		sort.Slice(implementationTypes, func(i, j int) bool { return implementationTypes[i].Name < implementationTypes[j].Name })
 		goType := &goInterfaceType{
-			GoName:          fragment.Name,
+			GoName:          name,
 			SharedFields:    fields,
 			Implementations: make([]*goStructType, len(implementationTypes)),
 			Selection:       fragment.SelectionSet,
 			descriptionInfo: desc,
 		}
-		g.typeMap[fragment.Name] = goType
+		g.typeMap[name] = goType
 
 		for i, implDef := range implementationTypes {
 			implFields, err := g.convertSelectionSet(
-				newPrefixList(fragment.Name), fragment.SelectionSet, implDef, directive)
+				newPrefixList(exportedName(g.Config.ExportPolicy.Fragments, fragment.Name)),
+				fragment.SelectionSet, implDef, directive)
 			if err != nil {
 				return nil, err
 			}
@@ -900,7 +1128,7 @@ func (g *generator) convertNamedFragment(fragment *ast.FragmentDefinition) (goTy
 			implDesc.GraphQLName = implDef.Name
 
 			implTyp := &goStructType{
-				GoName:          fragment.Name + upperFirst(implDef.Name),
+				GoName:          name + upperFirst(implDef.Name),
 				Fields:          implFields,
 				Selection:       fragment.SelectionSet,
 				descriptionInfo: implDesc,
@@ -917,6 +1145,40 @@ func (g *generator) convertNamedFragment(fragment *ast.FragmentDefinition) (goTy
 	}
 }
 
+// isConditionalField returns true if the given field has @skip or @include,
+// such that it may be absent from the response.
+func isConditionalField(field *ast.Field) bool {
+	return field.Directives.ForName("skip") != nil ||
+		field.Directives.ForName("include") != nil
+}
+
+// wrapConditionalField wraps the Go type of the given conditional field in
+// graphql.Optional, for Config.OptionalConditionalFields, so that callers
+// can tell whether it was skipped.
+func (g *generator) wrapConditionalField(field *ast.Field, typ goType) (goType, error) {
+	// Fields with custom (un)marshalers, including our helpers for
+	// interfaces, would need the template to unwrap the Optional.
+	switch unwrapped := typ.Unwrap().(type) {
+	case *goInterfaceType:
+		return nil, errorf(field.Position,
+			"use_optional_for_conditional_fields does not support fields of "+
+				"interface or union type (%v has type %v)",
+			field.Alias, field.Definition.Type.Name())
+	case *goOpaqueType:
+		if unwrapped.Marshaler != "" || unwrapped.Unmarshaler != "" {
+			return nil, errorf(field.Position,
+				"use_optional_for_conditional_fields does not support fields "+
+					"with a custom marshaler or unmarshaler (%v has type %v)",
+				field.Alias, field.Definition.Type.Name())
+		}
+	}
+	optionalRef, err := g.ref("github.com/Khan/genqlient/graphql.Optional")
+	if err != nil {
+		return nil, err
+	}
+	return &goGenericType{GoGenericRef: optionalRef, Elem: typ}, nil
+}
+
 // convertField converts a single GraphQL operation-field into a Go
 // struct-field (and its type).
 //
@@ -935,7 +1197,10 @@ func (g *generator) convertField(
 			field.Position, "undefined field %v", field.Alias)
 	}
 
-	goName := upperFirst(field.Alias)
+	goName := g.Config.Casing.fieldName(field.ObjectDefinition.Name, field.Alias)
+	if fieldOptions.FieldName != "" {
+		goName = fieldOptions.FieldName
+	}
 	namePrefix = nextPrefix(namePrefix, field)
 
 	fieldGoType, err := g.convertType(
@@ -945,11 +1210,45 @@ func (g *generator) convertField(
 		return nil, err
 	}
 
+	if g.Config.OptionalConditionalFields && isConditionalField(field) {
+		fieldGoType, err = g.wrapConditionalField(field, fieldGoType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Interface types need (un)marshaling helpers, which are unexported, so
+	// we can't use them from another package.
+	if iface, ok := fieldGoType.Unwrap().(*goInterfaceType); ok &&
+		strings.Contains(iface.GoName, ".") {
+		return nil, errorf(field.Position,
+			"fragments_package does not support fields of interface or union "+
+				"type in shared fragments (%v has type %v)",
+			field.Alias, field.Definition.Type.Name())
+	}
+
+	if fieldOptions.JSONTag != "" {
+		err = validateJSONTag(fieldOptions.JSONTag, field.Alias, fieldOptions.pos)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	graphQLType := field.Definition.Type
+	if isConditionalField(field) {
+		// The field may be absent from the response, even if non-null.
+		nullable := *graphQLType
+		nullable.NonNull = false
+		graphQLType = &nullable
+	}
+
 	return &goStructField{
 		GoName:      goName,
 		GoType:      fieldGoType,
 		JSONName:    field.Alias,
 		GraphQLName: field.Name,
 		Description: field.Definition.Description,
+		JSONTag:     fieldOptions.JSONTag,
+		GraphQLType: graphQLType,
 	}, nil
 }
@@ -9,6 +9,8 @@ package generate
 import (
 	"fmt"
 	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 // descriptionInfo is embedded in types whose descriptions may be more complex
@@ -79,3 +81,30 @@ func interfaceDescription(typ *goInterfaceType) string {
 			typ.GoName, typ.GraphQLName, implementationList))
 	}
 }
+
+// rootFieldsDescription returns the doc comment for the function for an
+// operation with no comment of its own (see Config.CopyDescriptions): the
+// schema's descriptions of the fields it selects, or "" if there are none.
+func rootFieldsDescription(goName string, op *ast.OperationDefinition) string {
+	var fields []string
+	for _, selection := range op.SelectionSet {
+		field, ok := selection.(*ast.Field)
+		if !ok || field.Definition == nil || field.Definition.Description == "" {
+			continue
+		}
+		// As in writeDescription, trim indentation, which would otherwise
+		// make a code block.
+		lines := strings.Split(field.Definition.Description, "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimLeft(line, " \t")
+		}
+		fields = append(fields, field.Alias+": "+strings.Join(lines, "\n"))
+	}
+	if len(fields) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(
+		"%s executes the %s %s.  The GraphQL documentation of the fields it "+
+			"selects follows.\n\n%s",
+		goName, op.Operation, op.Name, strings.Join(fields, "\n\n"))
+}
@@ -0,0 +1,259 @@
+package generate
+
+// This file implements `genqlient diff`, which compares the schema of a live
+// endpoint to the local schema, and reports the operations affected by the
+// differences.
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alexflint/go-arg"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// operationDiff describes the ways in which a single operation is affected
+// by the differences between two schemas.
+type operationDiff struct {
+	Name           string
+	SourceFilename string
+	Problems       []string
+}
+
+// diffOperations returns the operations in document (which must have been
+// validated against local) which are affected by the differences between
+// local and remote: those which are invalid against remote, or whose
+// selected fields or variables' types differ.
+func diffOperations(local, remote *ast.Schema, document *ast.QueryDocument) []*operationDiff {
+	// First compare the types, while the fields' definitions are those from
+	// local.  (Validating against remote will overwrite them, including those
+	// in fragments, so we must do this for all operations before validating
+	// any.)
+	problems := make([][]string, len(document.Operations))
+	for i, op := range document.Operations {
+		d := &typeDiffer{local: local, remote: remote, seenInputs: map[string]bool{}}
+		d.diffSelectionSet(op.SelectionSet, map[string]bool{})
+		for _, variable := range op.VariableDefinitions {
+			d.diffInputType(variable.Type.Name())
+		}
+		problems[i] = d.problems
+	}
+
+	var diffs []*operationDiff
+	for i, op := range document.Operations {
+		var opProblems []string
+		opDocument := &ast.QueryDocument{
+			Operations: ast.OperationList{op},
+			Fragments:  document.Fragments,
+		}
+		for _, err := range validator.Validate(remote, opDocument) {
+			if err.Rule == "NoUnusedFragments" {
+				continue // (other operations may use them)
+			}
+			opProblems = append(opProblems, err.Message)
+		}
+		opProblems = append(opProblems, problems[i]...)
+
+		if len(opProblems) > 0 {
+			sourceFilename := op.Position.Src.Name
+			if i := strings.LastIndex(sourceFilename, ":"); i != -1 {
+				sourceFilename = sourceFilename[:i]
+			}
+			diffs = append(diffs, &operationDiff{
+				Name:           op.Name,
+				SourceFilename: sourceFilename,
+				Problems:       opProblems,
+			})
+		}
+	}
+	return diffs
+}
+
+// typeDiffer collects the differences between local and remote in the types
+// used by a single operation.
+type typeDiffer struct {
+	local, remote *ast.Schema
+	seenInputs    map[string]bool
+	problems      []string
+}
+
+func (d *typeDiffer) addProblem(format string, args ...interface{}) {
+	d.problems = append(d.problems, fmt.Sprintf(format, args...))
+}
+
+// diffSelectionSet compares the types of the fields in the given selection
+// set; seenFragments avoids visiting each fragment more than once.
+func (d *typeDiffer) diffSelectionSet(selectionSet ast.SelectionSet, seenFragments map[string]bool) {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Definition != nil && selection.ObjectDefinition != nil &&
+				!strings.HasPrefix(selection.Name, "__") {
+				d.diffField(selection.ObjectDefinition.Name, selection.Definition)
+			}
+			d.diffSelectionSet(selection.SelectionSet, seenFragments)
+		case *ast.InlineFragment:
+			d.diffSelectionSet(selection.SelectionSet, seenFragments)
+		case *ast.FragmentSpread:
+			if selection.Definition != nil && !seenFragments[selection.Name] {
+				seenFragments[selection.Name] = true
+				d.diffSelectionSet(selection.Definition.SelectionSet, seenFragments)
+			}
+		}
+	}
+}
+
+// diffField compares the type of the given (local) field of the given type
+// to its type in remote.  (If it's missing entirely, validation will report
+// it.)
+func (d *typeDiffer) diffField(typeName string, field *ast.FieldDefinition) {
+	remoteType := d.remote.Types[typeName]
+	if remoteType == nil {
+		return
+	}
+	remoteField := remoteType.Fields.ForName(field.Name)
+	if remoteField == nil {
+		return
+	}
+	if field.Type.String() != remoteField.Type.String() {
+		d.addProblem("field %s.%s changed type from %s to %s",
+			typeName, field.Name, field.Type, remoteField.Type)
+	}
+}
+
+// diffInputType compares the given (named) input type, and the input types
+// it references, in local and remote.
+func (d *typeDiffer) diffInputType(name string) {
+	if d.seenInputs[name] {
+		return
+	}
+	d.seenInputs[name] = true
+
+	localDef, remoteDef := d.local.Types[name], d.remote.Types[name]
+	if localDef == nil || remoteDef == nil {
+		return // (validation will report it)
+	}
+
+	switch localDef.Kind {
+	case ast.Enum:
+		for _, val := range localDef.EnumValues {
+			if remoteDef.EnumValues.ForName(val.Name) == nil {
+				d.addProblem("enum value %s.%s was removed", name, val.Name)
+			}
+		}
+	case ast.InputObject:
+		for _, field := range localDef.Fields {
+			remoteField := remoteDef.Fields.ForName(field.Name)
+			if remoteField == nil {
+				d.addProblem("input field %s.%s was removed", name, field.Name)
+			} else if field.Type.String() != remoteField.Type.String() {
+				d.addProblem("input field %s.%s changed type from %s to %s",
+					name, field.Name, field.Type, remoteField.Type)
+			}
+			d.diffInputType(field.Type.Name())
+		}
+		for _, remoteField := range remoteDef.Fields {
+			if localDef.Fields.ForName(remoteField.Name) == nil &&
+				remoteField.Type.NonNull && remoteField.DefaultValue == nil {
+				d.addProblem("required input field %s.%s was added", name, remoteField.Name)
+			}
+		}
+	}
+}
+
+// writeOperationDiffs writes a human-readable description of diffs to w.
+func writeOperationDiffs(w io.Writer, diffs []*operationDiff) {
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Name < diffs[j].Name })
+	for _, diff := range diffs {
+		fmt.Fprintf(w, "%s (%s):\n", diff.Name, diff.SourceFilename)
+		for _, problem := range diff.Problems {
+			fmt.Fprintf(w, "\t%s\n", problem)
+		}
+	}
+}
+
+type diffArgs struct {
+	ConfigFilename string   `arg:"positional" placeholder:"CONFIG" default:"" help:"path to genqlient configuration (default: genqlient.yaml in current or any parent directory)"`
+	Endpoint       string   `arg:"--endpoint,required" help:"URL of the GraphQL endpoint to introspect"`
+	Header         []string `arg:"--header,separate" placeholder:"KEY: VALUE" help:"HTTP header to send with the introspection query; may be repeated"`
+}
+
+func (diffArgs) Description() string {
+	return strings.TrimSpace(`
+Compares the schema of a live GraphQL endpoint, fetched via introspection, to
+the local schema, and lists the operations affected by the differences.
+Exits with status 1 if any are.
+`)
+}
+
+// readConfigAndDiff implements `genqlient diff`; it writes the affected
+// operations to w, and returns an error if there are any.
+func readConfigAndDiff(args *diffArgs, w io.Writer) error {
+	header := http.Header{}
+	for _, h := range args.Header {
+		key, value, ok := strings.Cut(h, ":")
+		if !ok {
+			return errorf(nil, "invalid header %q: must be of the form KEY: VALUE", h)
+		}
+		header.Add(strings.TrimSpace(key), strings.TrimSpace(value))
+	}
+
+	config, err := readConfig(args.ConfigFilename)
+	if err != nil {
+		return err
+	}
+	local, err := getSchema(config.Schema)
+	if err != nil {
+		return err
+	}
+	document, err := getAndValidateQueries(
+		config.baseDir, config.Operations, config.OperationFunctions, local,
+		config.FragmentsPackage != "")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	remote, err := fetchSchema(ctx, args.Endpoint, header)
+	if err != nil {
+		return err
+	}
+
+	diffs := diffOperations(local, remote, document)
+	if len(diffs) == 0 {
+		fmt.Fprintln(w, "No operations are affected.")
+		return nil
+	}
+	writeOperationDiffs(w, diffs)
+	return errorf(nil, "%d operation(s) are affected by differences from the schema at %v",
+		len(diffs), args.Endpoint)
+}
+
+// diffMain is the entrypoint for `genqlient diff`; args are those following
+// "diff".
+func diffMain(args []string) error {
+	var parsed diffArgs
+	p, err := arg.NewParser(arg.Config{Program: "genqlient diff"}, &parsed)
+	if err != nil {
+		return err
+	}
+	err = p.Parse(args)
+	switch err {
+	case nil:
+	case arg.ErrHelp:
+		p.WriteHelp(os.Stdout)
+		return nil
+	default:
+		p.WriteUsage(os.Stdout)
+		return err
+	}
+	return readConfigAndDiff(&parsed, os.Stdout)
+}
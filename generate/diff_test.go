@@ -0,0 +1,47 @@
+package generate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Khan/genqlient/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	dir := filepath.Join("testdata", "diff")
+	introspection, err := os.ReadFile(filepath.Join(dir, "introspection.json"))
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write(introspection)
+	}))
+	defer server.Close()
+
+	var out strings.Builder
+	err = readConfigAndDiff(&diffArgs{
+		ConfigFilename: filepath.Join(dir, "genqlient.yaml"),
+		Endpoint:       server.URL,
+		Header:         []string{"Authorization: Bearer secret"},
+	}, &out)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "3 operation(s) are affected")
+	testutil.Cupaloy.SnapshotT(t, out.String())
+
+	out.Reset()
+	err = readConfigAndDiff(&diffArgs{
+		ConfigFilename: filepath.Join(dir, "genqlient.yaml"),
+		Endpoint:       server.URL,
+	}, &out)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401 Unauthorized")
+}
@@ -0,0 +1,123 @@
+package generate
+
+// This file generates the fake-response constructors enabled by the
+// generate_fakes option.  For an operation
+//	query GetUser { user { id name friends { name } } }
+// we generate
+//	func NewFakeGetUserResponse() *GetUserResponse {
+//		return &GetUserResponse{
+//			User: GetUserUser{
+//				Id:      "id",
+//				Name:    "name",
+//				Friends: []GetUserUserFriendsUser{{Name: "name"}},
+//			},
+//		}
+//	}
+// which is useful in tests which need a realistic-looking response.
+
+import (
+	"fmt"
+	"strings"
+)
+
+// fakeValue returns a Go expression for a fake value of the given type, or
+// the empty string if we just use the zero value.  name is the JSON name of
+// the field from which the value came, which we use as the value of string
+// fields.
+func fakeValue(typ goType, name string) string {
+	switch typ := typ.(type) {
+	case *goOpaqueType:
+		return fakeBuiltinValue(typ.GoRef, name)
+	case *goTypenameForBuiltinType:
+		value := fakeBuiltinValue(typ.GoBuiltinName, name)
+		if value == "" {
+			return ""
+		}
+		return fmt.Sprintf("%s(%s)", typ.GoTypeName, value)
+	case *goEnumType:
+		if len(typ.Values) == 0 {
+			return ""
+		}
+		return typ.Values[0].GoName
+	case *goSliceType:
+		elem := fakeValue(typ.Elem, name)
+		if elem == "" {
+			return fmt.Sprintf("make(%s, 1)", typ.Reference())
+		}
+		// Elide the element type of composite literals, as gofmt -s would.
+		elemRef := typ.Elem.Reference()
+		if strings.HasPrefix(elem, elemRef+"{") {
+			elem = elem[len(elemRef):]
+		} else if ref := strings.TrimPrefix(elemRef, "*"); ref != elemRef &&
+			strings.HasPrefix(elem, "&"+ref+"{") {
+			elem = elem[len(ref)+1:]
+		}
+		return fmt.Sprintf("%s{%s}", typ.Reference(), elem)
+	case *goPointerType:
+		elem := fakeValue(typ.Elem, name)
+		switch {
+		case elem == "":
+			return fmt.Sprintf("new(%s)", typ.Elem.Reference())
+		case strings.HasPrefix(elem, typ.Elem.Reference()+"{"):
+			// (a struct literal, whose address we can take directly)
+			return "&" + elem
+		default:
+			return fmt.Sprintf("func() %s { v := %s(%s); return &v }()",
+				typ.Reference(), typ.Elem.Reference(), elem)
+		}
+	case *goStructType:
+		var builder strings.Builder
+		builder.WriteString(typ.Reference() + "{\n")
+		for _, field := range typ.Fields {
+			value := fakeValue(field.GoType, field.JSONName)
+			if field.GraphQLName == "__typename" {
+				value = fakeValue(field.GoType, typ.GraphQLName)
+			}
+			if value != "" {
+				fmt.Fprintf(&builder, "%s: %s,\n", field.Selector(), value)
+			}
+		}
+		builder.WriteString("}")
+		return builder.String()
+	case *goInterfaceType:
+		// We use the first implementation; any will do.
+		if len(typ.Implementations) == 0 {
+			return ""
+		}
+		return "&" + fakeValue(typ.Implementations[0], name)
+	default:
+		// e.g. goGenericType, which we have no general way to construct.
+		return ""
+	}
+}
+
+// fakeBuiltinValue returns the fake value for the given Go builtin type, or
+// the empty string for other types (e.g. those bound to custom scalars).
+func fakeBuiltinValue(goRef, name string) string {
+	switch goRef {
+	case "string":
+		return fmt.Sprintf("%q", name)
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64":
+		return "1"
+	case "float32", "float64":
+		return "1.5"
+	case "bool":
+		return "true"
+	default:
+		return ""
+	}
+}
+
+// writeFake returns the declaration of the fake-response constructor for an
+// operation whose response-type is responseType.
+func writeFake(responseType goType) string {
+	name := "NewFake" + responseType.Reference()
+	return fmt.Sprintf(
+		"// %s returns a %s\n"+
+			"// populated with fake values, for tests.  Each list has one element, and\n"+
+			"// each interface holds its first implementation.\n"+
+			"func %s() *%s {\nreturn &%s\n}\n",
+		name, responseType.Reference(), name, responseType.Reference(),
+		fakeValue(responseType, ""))
+}
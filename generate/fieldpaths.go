@@ -0,0 +1,136 @@
+package generate
+
+// This file generates the field-path variables enabled by the
+// generate_field_paths option.  For an operation
+//	query GetUsers { users { id profile { name } } }
+// we generate
+//	var GetUsersFields = struct {
+//		Users struct {
+//			Id      string
+//			Profile struct{ Name string }
+//		}
+//	}{...}
+// where GetUsersFields.Users.Profile.Name is "users.profile.name".  This is
+// useful for servers which accept field-paths for sorting or filtering.
+
+import (
+	"fmt"
+	"strings"
+)
+
+// fieldPath represents a field selected by an operation, along with the
+// fields selected within it (if any).
+type fieldPath struct {
+	GoName string
+	// e.g. "users.profile.name"
+	Path string
+	// nil for leaf fields (and non-nil, even if empty, for others)
+	Children []*fieldPath
+}
+
+// fieldPaths returns the field-paths selected within the given type, each
+// prefixed by the given path.
+//
+// We use the GraphQL field-names (not aliases) because the paths are intended
+// to be sent back to the server, which knows nothing of our aliases.
+func fieldPaths(prefix string, typ goType) ([]*fieldPath, error) {
+	switch typ := typ.Unwrap().(type) {
+	case *goStructType:
+		fields, err := typ.FlattenedFields()
+		if err != nil {
+			return nil, err
+		}
+		retval := []*fieldPath{} // non-nil: this is not a leaf
+		for _, field := range fields {
+			if field.GraphQLName == "__typename" {
+				continue
+			}
+			path := field.GraphQLName
+			if prefix != "" {
+				path = prefix + "." + path
+			}
+			children, err := fieldPaths(path, field.GoType)
+			if err != nil {
+				return nil, err
+			}
+			retval = append(retval, &fieldPath{field.GoName, path, children})
+		}
+		return retval, nil
+	case *goInterfaceType:
+		// A field may be selected on only some of the implementations; we
+		// include all of them.
+		retval := []*fieldPath{}
+		for _, impl := range typ.Implementations {
+			implPaths, err := fieldPaths(prefix, impl)
+			if err != nil {
+				return nil, err
+			}
+			retval = mergeFieldPaths(retval, implPaths)
+		}
+		return retval, nil
+	default:
+		return nil, nil
+	}
+}
+
+// mergeFieldPaths adds the paths in b that aren't already in a to a, merging
+// their children recursively.
+func mergeFieldPaths(a, b []*fieldPath) []*fieldPath {
+	for _, bPath := range b {
+		found := false
+		for _, aPath := range a {
+			if aPath.GoName == bPath.GoName {
+				aPath.Children = mergeFieldPaths(aPath.Children, bPath.Children)
+				found = true
+				break
+			}
+		}
+		if !found {
+			a = append(a, bPath)
+		}
+	}
+	return a
+}
+
+func fieldPathsType(paths []*fieldPath) string {
+	var builder strings.Builder
+	builder.WriteString("struct {\n")
+	for _, path := range paths {
+		if path.Children == nil {
+			fmt.Fprintf(&builder, "%s string\n", path.GoName)
+		} else {
+			fmt.Fprintf(&builder, "%s %s\n", path.GoName, fieldPathsType(path.Children))
+		}
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
+
+func fieldPathsValue(paths []*fieldPath) string {
+	var builder strings.Builder
+	builder.WriteString(fieldPathsType(paths))
+	builder.WriteString("{\n")
+	for _, path := range paths {
+		if path.Children == nil {
+			fmt.Fprintf(&builder, "%s: %q,\n", path.GoName, path.Path)
+		} else {
+			fmt.Fprintf(&builder, "%s: %s,\n", path.GoName, fieldPathsValue(path.Children))
+		}
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
+
+// writeFieldPaths returns the declaration of the field-path variable for the
+// given operation, whose response-type is responseType.
+func writeFieldPaths(opName string, responseType goType) (string, error) {
+	paths, err := fieldPaths("", responseType)
+	if err != nil {
+		return "", err
+	}
+	name := opName + "Fields"
+	return fmt.Sprintf(
+		"// %s contains the GraphQL path of each field selected by %s.\n"+
+			"var %s = %s\n",
+		name, opName, name, fieldPathsValue(paths)), nil
+}
@@ -0,0 +1,93 @@
+package generate
+
+// This file implements the flattenInput option (see
+// docs/genqlient_directive.graphql), which makes the generated function for
+// an operation whose only variable is an input object take that object's
+// fields as parameters, rather than the object itself.
+
+import "github.com/vektah/gqlparser/v2/ast"
+
+// flattenedInput describes the parameters of an operation with
+// @genqlient(flattenInput: true); see operation.go.tmpl.
+type flattenedInput struct {
+	// The operation's single variable, whose value we construct from Params.
+	Variable *goStructField
+	// The composite-literal type with which we construct the variable: the
+	// input type's name, with a leading & if the variable is a pointer.
+	Constructor string
+	Params      []*flattenedInputParam
+}
+
+type flattenedInputParam struct {
+	// The name of the parameter, which is the field's GraphQL name (with
+	// trailing underscores if that's reserved; see flattenedParamName).
+	Name  string
+	Field *goStructField
+}
+
+// flattenInput returns the flattened parameters for the given operation,
+// whose arguments convertArguments converted to inputType.
+func flattenInput(
+	op *ast.OperationDefinition,
+	inputType *goStructType,
+	pos *ast.Position,
+) (*flattenedInput, error) {
+	if inputType == nil || len(inputType.Fields) != 1 {
+		return nil, errorf(pos,
+			"flattenInput may only be used on operations with exactly one variable")
+	}
+
+	variable := inputType.Fields[0]
+	constructor := ""
+	typ := variable.GoType
+	if ptr, ok := typ.(*goPointerType); ok {
+		constructor = "&"
+		typ = ptr.Elem
+	}
+	structType, ok := typ.(*goStructType)
+	if !ok || !structType.IsInput {
+		return nil, errorf(pos,
+			"flattenInput may only be used on operations whose variable is "+
+				"a single input object, not %s", op.VariableDefinitions[0].Type)
+	}
+
+	params := make([]*flattenedInputParam, len(structType.Fields))
+	used := map[string]bool{}
+	for name := range operationLocals {
+		used[name] = true
+	}
+	for i, field := range structType.Fields {
+		name := flattenedParamName(field.GraphQLName, used)
+		used[name] = true
+		params[i] = &flattenedInputParam{Name: name, Field: field}
+	}
+
+	return &flattenedInput{
+		Variable:    variable,
+		Constructor: constructor + structType.Reference(),
+		Params:      params,
+	}, nil
+}
+
+// operationLocals are the identifiers, other than its parameters, which the
+// body of a generated operation function declares or refers to (see
+// operation.go.tmpl), and which a flattened parameter thus must not shadow.
+// Operation arguments get their names from the query, where genqlient's
+// locals' trailing underscores suffice to avoid conflicts, but flattened
+// parameters get theirs from the schema, so we guard against more.
+var operationLocals = map[string]bool{
+	"graphql": true, "context": true, "ctx": true, "client": true,
+	"ctx_": true, "client_": true, "opts_": true,
+	"req_": true, "err_": true, "data_": true, "resp_": true,
+}
+
+// flattenedParamName returns the parameter name for the input field with
+// the given GraphQL name: the name itself, with underscores appended until
+// it's neither a Go keyword nor in used.
+func flattenedParamName(graphQLName string, used map[string]bool) string {
+	name := graphQLName
+	for goKeywords[name] || used[name] {
+		name += "_"
+	}
+	return name
+}
@@ -9,9 +9,11 @@ import (
 	"encoding/json"
 	"go/format"
 	"io"
+	"path/filepath"
 	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/formatter"
@@ -43,6 +45,16 @@ type generator struct {
 	// ast.FragmentSpread.Definition, but for some reason it doesn't seem to be
 	// set consistently, even post-validation.
 	fragments map[string]*ast.FragmentDefinition
+	// True while we are converting a named fragment whose types live in
+	// Config.FragmentsPackage, rather than in the package we're generating.
+	inSharedFragment bool
+	// The representation helpers, if enabled (see
+	// Config.GenerateRepresentations and representations.go).
+	representations string
+	// The layouts of the scalars bound with TypeBinding.TimeLayout, by
+	// GraphQL name, for which we generate (un)marshalers (see
+	// timelayout.go).
+	timeLayouts map[string]string
 }
 
 // JSON tags in operation are for ExportOperations (see Config for details).
@@ -51,6 +63,9 @@ type operation struct {
 	Type ast.Operation `json:"-"`
 	// The name of the operation, from GraphQL.
 	Name string `json:"operationName"`
+	// The name of the operation's function in Go, which is Name unless
+	// export_policy says otherwise.
+	GoName string `json:"-"`
 	// The documentation for the operation, from GraphQL.
 	Doc string `json:"-"`
 	// The body of the operation to send.
@@ -60,10 +75,34 @@ type operation struct {
 	// machinery we have for handling (and, specifically, json-marshaling)
 	// types.
 	Input *goStructType `json:"-"`
+	// The parameters which construct Input, if the operation has
+	// @genqlient(flattenInput: true); see flatteninput.go.
+	FlattenedInput *flattenedInput `json:"-"`
 	// The type-name for the operation's response type.
 	ResponseName string `json:"-"`
 	// The original filename from which we got this query.
 	SourceFilename string `json:"sourceLocation"`
+	// The declaration of the field-path variable for this operation, if
+	// enabled (see Config.GenerateFieldPaths and fieldpaths.go).
+	FieldPaths string `json:"-"`
+	// The endpoint to which to send this operation, if overridden via
+	// @genqlient(endpoint: ...).
+	Endpoint string `json:"-"`
+	// The Go expression for the operation's cache TTL, if set via
+	// @genqlient(cacheTTL: ...); see cachettl.go.
+	CacheTTL string `json:"-"`
+	// The declaration of the fake-response constructor for this operation,
+	// if enabled (see Config.GenerateFakes and fakes.go).
+	Fake string `json:"-"`
+	// The complexity of the operation, if enabled (see
+	// Config.GenerateComplexity and complexity.go).
+	Complexity int `json:"-"`
+	// The description of this operation for the catalog, if enabled (see
+	// Config.EmitCatalog and catalog.go).
+	Catalog *catalogOperation `json:"-"`
+	// The JSON Schema for the operation's variables, if enabled (see
+	// Config.EmitVariablesJSONSchema and jsonschema.go).
+	VariablesJSONSchema *jsonSchema `json:"-"`
 	// The config within which we are generating code.
 	Config *Config `json:"-"`
 }
@@ -83,6 +122,7 @@ func newGenerator(
 		imports:       map[string]string{},
 		usedAliases:   map[string]bool{},
 		templateCache: map[string]*template.Template{},
+		timeLayouts:   map[string]string{},
 		schema:        schema,
 		fragments:     make(map[string]*ast.FragmentDefinition, len(fragments)),
 	}
@@ -95,17 +135,16 @@ func newGenerator(
 }
 
 func (g *generator) WriteTypes(w io.Writer) error {
-	names := make([]string, 0, len(g.typeMap))
-	for name := range g.typeMap {
-		names = append(names, name)
-	}
 	// Sort alphabetically by type-name.  Sorting somehow deterministically is
 	// important to ensure generated code is deterministic.  Alphabetical is
 	// nice because it's easy, and in the current naming scheme, it's even
 	// vaguely aligned to the structure of the queries.
-	sort.Strings(names)
-
-	for _, name := range names {
+	for _, name := range sortedKeys(g.typeMap) {
+		if strings.Contains(name, ".") {
+			// This type is defined in the fragments_package (see
+			// Config.FragmentsPackage); we just refer to it.
+			continue
+		}
 		err := g.typeMap[name].WriteDefinition(w, g)
 		if err != nil {
 			return err
@@ -156,7 +195,8 @@ func (g *generator) usedFragments(op *ast.OperationDefinition) ast.FragmentDefin
 //
 // At present, the only change is that we add __typename, if not already
 // requested, to each field of interface type, so we can use the right types
-// when unmarshaling.
+// when unmarshaling.  (With typename_strategy: always, we add it to each
+// field of object type as well.)
 func (g *generator) preprocessQueryDocument(doc *ast.QueryDocument) {
 	var observers validator.Events
 	// We want to ensure that everywhere you ask for some list of fields (a
@@ -186,8 +226,15 @@ func (g *generator) preprocessQueryDocument(doc *ast.QueryDocument) {
 		//	field { __typename subField ... }
 
 		fieldType := g.schema.Types[field.Definition.Type.Name()]
-		if fieldType.Kind != ast.Interface && fieldType.Kind != ast.Union {
-			return // a concrete type
+		switch fieldType.Kind {
+		case ast.Interface, ast.Union:
+			// always needs __typename
+		case ast.Object:
+			if g.Config.TypenameStrategy != "always" {
+				return // a concrete type
+			}
+		default:
+			return // a leaf type, with no selection-set
 		}
 
 		hasTypename := false
@@ -236,13 +283,19 @@ func (g *generator) validateOperation(op *ast.OperationDefinition) error {
 
 	if op.Name == "" {
 		return errorf(op.Position, "operations must have operation-names")
-	} else if goKeywords[op.Name] {
+	} else if goKeywords[g.operationGoName(op)] {
 		return errorf(op.Position, "operation name must not be a go keyword")
 	}
 
 	return nil
 }
 
+// operationGoName returns the Go name of the function for the given
+// operation, from which we also name its types.
+func (g *generator) operationGoName(op *ast.OperationDefinition) string {
+	return exportedName(g.Config.ExportPolicy.Operations, op.Name)
+}
+
 // addOperation adds to g.Operations the information needed to generate a
 // genqlient entrypoint function for the given operation.  It also adds to
 // g.typeMap any types referenced by the operation, except for types belonging
@@ -253,6 +306,10 @@ func (g *generator) addOperation(op *ast.OperationDefinition) error {
 		return err
 	}
 
+	if err := g.addOptionalFragments(op); err != nil {
+		return err
+	}
+
 	queryDoc := &ast.QueryDocument{
 		Operations: ast.OperationList{op},
 		Fragments:  g.usedFragments(op),
@@ -273,11 +330,22 @@ func (g *generator) addOperation(op *ast.OperationDefinition) error {
 		return err
 	}
 
+	var flattened *flattenedInput
+	if directive.GetFlattenInput() {
+		flattened, err = flattenInput(op, inputType, directive.pos)
+		if err != nil {
+			return err
+		}
+	}
+
 	responseType, err := g.convertOperation(op, directive)
 	if err != nil {
 		return err
 	}
 
+	if commentLines == "" && g.Config.CopyDescriptions {
+		commentLines = rootFieldsDescription(g.operationGoName(op), op)
+	}
 	var docComment string
 	if commentLines != "" {
 		docComment = "// " + strings.ReplaceAll(commentLines, "\n", "\n// ")
@@ -293,23 +361,96 @@ func (g *generator) addOperation(op *ast.OperationDefinition) error {
 		sourceFilename = sourceFilename[:i]
 	}
 
+	var fieldPaths string
+	if g.Config.GenerateFieldPaths {
+		fieldPaths, err = writeFieldPaths(g.operationGoName(op), responseType)
+		if err != nil {
+			return err
+		}
+	}
+
+	var fake string
+	if g.Config.GenerateFakes {
+		fake = writeFake(responseType)
+	}
+
+	var cacheTTL string
+	if directive.CacheTTL != "" {
+		// (validated in genqlientDirective.validate)
+		ttl, _ := time.ParseDuration(directive.CacheTTL)
+		cacheTTL, err = g.durationLiteral(ttl)
+		if err != nil {
+			return err
+		}
+	}
+
+	var complexity int
+	if g.Config.GenerateComplexity {
+		complexity = selectionSetComplexity(op.SelectionSet)
+	}
+
+	var catalogOp *catalogOperation
+	if g.Config.EmitCatalog != "" {
+		catalogOp, err = catalogOperationFor(
+			op, commentLines, sourceFilename, inputType, responseType)
+		if err != nil {
+			return err
+		}
+	}
+
+	var variablesSchema *jsonSchema
+	if g.Config.EmitVariablesJSONSchema != "" {
+		variablesSchema, err = variablesJSONSchemaFor(g.schema, op, commentLines)
+		if err != nil {
+			return err
+		}
+	}
+
 	g.Operations = append(g.Operations, &operation{
-		Type: op.Operation,
-		Name: op.Name,
-		Doc:  docComment,
+		Type:   op.Operation,
+		Name:   op.Name,
+		GoName: g.operationGoName(op),
+		Doc:    docComment,
 		// The newline just makes it format a little nicer.  We add it here
 		// rather than in the template so exported operations will match
 		// *exactly* what we send to the server.
-		Body:           "\n" + builder.String(),
-		Input:          inputType,
-		ResponseName:   responseType.Reference(),
-		SourceFilename: sourceFilename,
-		Config:         g.Config, // for the convenience of the template
+		Body:                "\n" + builder.String(),
+		Input:               inputType,
+		FlattenedInput:      flattened,
+		ResponseName:        responseType.Reference(),
+		SourceFilename:      sourceFilename,
+		FieldPaths:          fieldPaths,
+		Fake:                fake,
+		Endpoint:            directive.Endpoint,
+		CacheTTL:            cacheTTL,
+		Complexity:          complexity,
+		Catalog:             catalogOp,
+		VariablesJSONSchema: variablesSchema,
+		Config:              g.Config, // for the convenience of the template
 	})
 
 	return nil
 }
 
+// addSchemaTypes adds to g.typeMap all the input-object and enum types in the
+// schema, for Config.TypesOnly.  (Other types are only generated for the
+// selections of some operation or fragment; scalars just use their
+// bindings.)
+func (g *generator) addSchemaTypes() error {
+	for _, name := range sortedKeys(g.schema.Types) {
+		def := g.schema.Types[name]
+		if def.BuiltIn || (def.Kind != ast.InputObject && def.Kind != ast.Enum) {
+			continue
+		}
+		_, err := g.convertDefinition(nil, def, def.Position, nil,
+			&genqlientDirective{}, &genqlientDirective{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Generate is the main programmatic entrypoint to genqlient, and generates and
 // returns Go source code based on the given configuration.
 //
@@ -325,21 +466,46 @@ func Generate(config *Config) (map[string][]byte, error) {
 		return nil, err
 	}
 
-	document, err := getAndValidateQueries(config.baseDir, config.Operations, schema)
-	if err != nil {
-		return nil, err
+	// In types_only mode, we don't look at the operations at all.
+	document := &ast.QueryDocument{}
+	if !config.TypesOnly {
+		document, err = getAndValidateQueries(
+			config.baseDir, config.Operations, config.OperationFunctions, schema,
+			config.FragmentsPackage != "")
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// TODO(benkraft): we could also allow this, and generate an empty file
 	// with just the package-name, if it turns out to be more convenient that
 	// way.  (As-is, we generate a broken file, with just (unused) imports.)
-	if len(document.Operations) == 0 {
+	if !config.TypesOnly &&
+		len(document.Operations) == 0 && len(document.Fragments) == 0 {
 		// Hard to have a position when there are no operations :(
 		return nil, errorf(nil,
 			"no queries found, looked in: %v (configure this in genqlient.yaml)",
 			strings.Join(config.Operations, ", "))
 	}
 
+	// Steps 2 and 3 are genqlient's own code generation, which we run as
+	// the first plugin, before any in config.Plugins (which may add to, or
+	// modify, the files it generates).
+	ctx := &GenContext{Config: config, Schema: schema, Document: document, Files: map[string][]byte{}}
+	if err = runPlugins(append([]Plugin{builtinPlugin{}}, config.Plugins...), ctx); err != nil {
+		return nil, err
+	}
+	return ctx.Files, nil
+}
+
+// builtinPlugin is the [Plugin] which does genqlient's own code generation;
+// [Generate] runs it before the plugins in [Config.Plugins].
+type builtinPlugin struct{}
+
+func (builtinPlugin) Generate(ctx *GenContext) error {
+	config, schema, document := ctx.Config, ctx.Schema, ctx.Document
+	var err error
+
 	// Step 2: For each operation and fragment, convert it into data structures
 	// representing Go types (defined in types.go).  The bulk of this logic is
 	// in convert.go, and it additionally updates g.typeMap to include all the
@@ -347,7 +513,87 @@ func Generate(config *Config) (map[string][]byte, error) {
 	g := newGenerator(config, schema, document.Fragments)
 	for _, op := range document.Operations {
 		if err = g.addOperation(op); err != nil {
-			return nil, err
+			return err
+		}
+	}
+	// If there are only fragments, this is a package of shared fragments (for
+	// use as another package's fragments_package), so we generate all of
+	// them.  (Otherwise, we generate only those used by some operation.)
+	if config.TypesOnly {
+		if err = g.addSchemaTypes(); err != nil {
+			return err
+		}
+	} else if len(document.Operations) == 0 {
+		g.preprocessQueryDocument(&ast.QueryDocument{Fragments: document.Fragments})
+		for _, fragment := range document.Fragments {
+			if _, ok := g.typeMap[exportedName(g.Config.ExportPolicy.Fragments, fragment.Name)]; ok {
+				continue // already converted, as a dependency of another
+			}
+			if _, err = g.convertNamedFragment(fragment); err != nil {
+				return err
+			}
+		}
+	}
+
+	if config.GenerateRepresentations {
+		if err = g.addRepresentations(); err != nil {
+			return err
+		}
+	}
+
+	g.breakInputCycles()
+
+	// Types share a namespace with the functions, constants, and variables
+	// we generate for each operation; a user-specified typename (or a
+	// fragment name) can collide with those.  So can the field-paths
+	// variable of one operation and the function of another.
+	opsByGoName := make(map[string]*ast.OperationDefinition, len(document.Operations))
+	for _, op := range document.Operations {
+		opsByGoName[g.operationGoName(op)] = op
+	}
+	for _, op := range document.Operations {
+		goName := g.operationGoName(op)
+		names := []string{goName, goName + "_Operation"}
+		if config.GenerateFieldPaths {
+			fieldsName := goName + "Fields"
+			if other, ok := opsByGoName[fieldsName]; ok {
+				return errorf(op.Position,
+					"field-paths variable %s for operation %s conflicts "+
+						"with the function generated for operation %s; "+
+						"rename one of the operations", fieldsName, op.Name, other.Name)
+			}
+			names = append(names, fieldsName)
+		}
+		for _, name := range names {
+			if _, ok := g.typeMap[name]; ok {
+				return errorf(op.Position,
+					"type name %s conflicts with the declarations generated "+
+						"for operation %s; choose a different typename or "+
+						"fragment name", name, op.Name)
+			}
+		}
+	}
+	// Likewise the interface we generate for each fragment, if
+	// generate_fragment_interfaces is set.
+	if config.GenerateFragmentInterfaces {
+		for _, fragment := range document.Fragments {
+			typ, ok := g.typeMap[exportedName(g.Config.ExportPolicy.Fragments, fragment.Name)].(*goStructType)
+			if !ok || !typ.FragmentInterface {
+				continue
+			}
+			name := typ.GoName + "Interface"
+			if op, ok := opsByGoName[name]; ok {
+				return errorf(fragment.Position,
+					"interface %s for fragment %s conflicts with the function "+
+						"generated for operation %s; rename the fragment or "+
+						"the operation", name, fragment.Name, op.Name)
+			}
+			if _, ok := g.typeMap[name]; ok {
+				return errorf(fragment.Position,
+					"interface %s for fragment %s conflicts with the type of "+
+						"the same name; choose a different typename or "+
+						"fragment name", name, fragment.Name)
+			}
 		}
 	}
 
@@ -358,7 +604,11 @@ func Generate(config *Config) (map[string][]byte, error) {
 	var bodyBuf bytes.Buffer
 	err = g.WriteTypes(&bodyBuf)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	err = g.writeTimeLayoutMarshalers(&bodyBuf)
+	if err != nil {
+		return err
 	}
 
 	// Sort operations to guarantee a stable order
@@ -369,7 +619,15 @@ func Generate(config *Config) (map[string][]byte, error) {
 	for _, operation := range g.Operations {
 		err = g.render("operation.go.tmpl", &bodyBuf, operation)
 		if err != nil {
-			return nil, err
+			return err
+		}
+	}
+	bodyBuf.WriteString(g.representations)
+
+	if g.Config.GenerateRegistry {
+		err = g.writeRegistry(&bodyBuf)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -378,52 +636,88 @@ func Generate(config *Config) (map[string][]byte, error) {
 	if g.Config.ContextType != "-" {
 		_, err = g.ref("context.Context")
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if g.Config.ContextType != "context.Context" {
 			_, err = g.ref(g.Config.ContextType)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 	}
 
 	// Now really glue it all together, and format.
+	header, err := g.generatedHeader()
+	if err != nil {
+		return err
+	}
 	var buf bytes.Buffer
+	buf.WriteString(header + "\n\n")
 	err = g.render("header.go.tmpl", &buf, g)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	_, err = io.Copy(&buf, &bodyBuf)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	unformatted := buf.Bytes()
 	formatted, err := format.Source(unformatted)
 	if err != nil {
-		return nil, goSourceError("gofmt", unformatted, err)
+		return goSourceError("gofmt", unformatted, err)
 	}
 	importsed, err := imports.Process(config.Generated, formatted, nil)
 	if err != nil {
-		return nil, goSourceError("goimports", formatted, err)
+		return goSourceError("goimports", formatted, err)
 	}
 
-	retval := map[string][]byte{
-		config.Generated: importsed,
-	}
+	ctx.Files[config.Generated] = importsed
 
 	if config.ExportOperations != "" {
 		// We use MarshalIndent so that the file is human-readable and
 		// slightly more likely to be git-mergeable (if you check it in).  In
 		// general it's never going to be used anywhere where space is an
 		// issue -- it doesn't go in your binary or anything.
-		retval[config.ExportOperations], err = json.MarshalIndent(
+		ctx.Files[config.ExportOperations], err = json.MarshalIndent(
 			exportedOperations{Operations: g.Operations}, "", "  ")
 		if err != nil {
-			return nil, errorf(nil, "unable to export queries: %v", err)
+			return errorf(nil, "unable to export queries: %v", err)
 		}
 	}
 
-	return retval, nil
+	if config.EmitCatalog != "" {
+		cat := catalog{Operations: make([]*catalogOperation, len(g.Operations))}
+		for i, op := range g.Operations {
+			cat.Operations[i] = op.Catalog
+		}
+		// As above, we indent for human-readability and mergeability.
+		ctx.Files[config.EmitCatalog], err = json.MarshalIndent(cat, "", "  ")
+		if err != nil {
+			return errorf(nil, "unable to emit catalog: %v", err)
+		}
+	}
+
+	if config.EmitVariablesJSONSchema != "" {
+		for _, op := range g.Operations {
+			filename := filepath.Join(config.EmitVariablesJSONSchema, op.Name+".json")
+			// As above, we indent for human-readability and mergeability.
+			ctx.Files[filename], err = json.MarshalIndent(op.VariablesJSONSchema, "", "  ")
+			if err != nil {
+				return errorf(nil, "unable to emit JSON schema for %s: %v", op.Name, err)
+			}
+		}
+	}
+
+	if config.EmitProto != "" {
+		protoFiles, err := g.protoFiles()
+		if err != nil {
+			return err
+		}
+		for filename, content := range protoFiles {
+			ctx.Files[filename] = content
+		}
+	}
+
+	return nil
 }
@@ -6,10 +6,12 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/Khan/genqlient/internal/testutil"
+	"github.com/stretchr/testify/assert"
 	"gopkg.in/yaml.v2"
 )
 
@@ -48,6 +50,16 @@ func buildGoFile(namePrefix string, content []byte) error {
 	return nil
 }
 
+// dateBinding returns the binding for the Date scalar used throughout the
+// test data (a new one each time, since config validation may modify it).
+func dateBinding() *TypeBinding {
+	return &TypeBinding{
+		Type:        "time.Time",
+		Marshaler:   "github.com/Khan/genqlient/internal/testutil.MarshalDate",
+		Unmarshaler: "github.com/Khan/genqlient/internal/testutil.UnmarshalDate",
+	}
+}
+
 // TestGenerate is a snapshot-based test of code-generation.
 //
 // This file just has the test runner; the actual data is all in
@@ -166,9 +178,36 @@ func TestGenerateWithConfig(t *testing.T) {
 		{"ExportOperations", "", nil, &Config{
 			ExportOperations: "operations.json",
 		}},
+		{"FieldNaming", "", []string{"SimpleNamedFragment.graphql", "InputObject.graphql"}, &Config{
+			Casing: Casing{
+				Initialisms: []string{"ID", "url"},
+				FieldNameOverrides: map[string]string{
+					"duration":            "DurationSeconds",
+					"UserQueryInput.name": "UserName",
+				},
+			},
+			Bindings: map[string]*TypeBinding{
+				"Date": dateBinding(),
+			},
+		}},
+		{"EmitCatalog", "", []string{"SimpleInput.graphql", "SimpleNamedFragment.graphql"}, &Config{
+			EmitCatalog: "catalog.json",
+		}},
+		{"EmitVariablesJSONSchema", "", []string{
+			"DefaultInputs.graphql", "InputObject.graphql", "ListInput.graphql", "OneOfInput.graphql",
+		}, &Config{
+			EmitVariablesJSONSchema: "variables",
+			Bindings: map[string]*TypeBinding{
+				"Date": dateBinding(),
+			},
+		}},
 		{"CustomContext", "", nil, &Config{
 			ContextType: "github.com/Khan/genqlient/internal/testutil.MyContext",
 		}},
+		{"ContextAdapter", "", []string{"SimpleQuery.graphql", "Endpoint.graphql"}, &Config{
+			ContextType:    "*github.com/Khan/genqlient/internal/testutil.MyAdaptedContext",
+			ContextAdapter: "github.com/Khan/genqlient/internal/testutil.ContextFromMyAdaptedContext",
+		}},
 		{"CustomContextWithAlias", "", nil, &Config{
 			ContextType: "github.com/Khan/genqlient/internal/testutil/junk---fun.name.MyContext",
 		}},
@@ -201,6 +240,13 @@ func TestGenerateWithConfig(t *testing.T) {
 		{"NoContext", "", nil, &Config{
 			ContextType: "-",
 		}},
+		{"ContextLast", "", []string{"SimpleInput.graphql", "SimpleQuery.graphql"}, &Config{
+			ContextPosition: "last",
+		}},
+		{"ContextLastWithOperationOptions", "", []string{"SimpleInput.graphql"}, &Config{
+			ContextPosition:  "last",
+			OperationOptions: true,
+		}},
 		{"ClientGetter", "", nil, &Config{
 			ClientGetter: "github.com/Khan/genqlient/internal/testutil.GetClientFromContext",
 		}},
@@ -215,18 +261,22 @@ func TestGenerateWithConfig(t *testing.T) {
 		{"Extensions", "", nil, &Config{
 			Extensions: true,
 		}},
-		{"OptionalValue", "", []string{"ListInput.graphql", "QueryWithSlices.graphql"}, &Config{
+		{"ResponseType", "", []string{"SimpleQuery.graphql", "SimpleMutation.graphql"}, &Config{
+			ResponseType: "github.com/Khan/genqlient/internal/testutil.ResponseWithRequestID",
+		}},
+		{"OptionalValue", "", []string{"ListInput.graphql", "ListNullability.graphql", "QueryWithSlices.graphql"}, &Config{
 			Optional: "value",
 		}},
 		{"OptionalPointer", "", []string{
 			"ListInput.graphql",
+			"ListNullability.graphql",
 			"QueryWithSlices.graphql",
 			"SimpleQueryWithPointerFalseOverride.graphql",
 			"SimpleQueryNoOverride.graphql",
 		}, &Config{
 			Optional: "pointer",
 		}},
-		{"OptionalGeneric", "", []string{"ListInput.graphql", "QueryWithSlices.graphql"}, &Config{
+		{"OptionalGeneric", "", []string{"ListInput.graphql", "ListNullability.graphql", "QueryWithSlices.graphql"}, &Config{
 			Optional:            "generic",
 			OptionalGenericType: "github.com/Khan/genqlient/internal/testutil.Option",
 		}},
@@ -245,6 +295,156 @@ func TestGenerateWithConfig(t *testing.T) {
 				StructReferences: true,
 			},
 		},
+		{"GenerateFieldPaths", "", []string{"ComplexNamedFragments.graphql", "SimpleQuery.graphql"}, &Config{
+			GenerateFieldPaths: true,
+		}},
+		{"OperationOptions", "", []string{"SimpleInput.graphql", "SimpleQuery.graphql"}, &Config{
+			OperationOptions: true,
+		}},
+		{"OperationOptionsNoContext", "", nil, &Config{
+			OperationOptions: true,
+			ContextType:      "-",
+		}},
+		{"OperationOptionsWithEndpoint", "", []string{"Endpoint.graphql"}, &Config{
+			OperationOptions: true,
+		}},
+		{"InputFieldOrderAlpha", "", []string{"InputObject.graphql"}, &Config{
+			InputFieldOrder: "alpha",
+			Bindings: map[string]*TypeBinding{
+				"Date": dateBinding(),
+			},
+		}},
+		{"GenerateInputBuilders", "", []string{"Hasura.graphql", "SimpleInput.graphql"}, &Config{
+			GenerateInputBuilders: true,
+		}},
+		{"GenerateInputMerge", "", []string{"InputObject.graphql", "Hasura.graphql"}, &Config{
+			GenerateInputMerge: true,
+			Bindings: map[string]*TypeBinding{
+				"Date": dateBinding(),
+			},
+		}},
+		{"GenerateValidation", "", []string{"InputObject.graphql", "OneOfInput.graphql", "SignupWithConstraints.graphql"}, &Config{
+			GenerateValidation: true,
+			Bindings: map[string]*TypeBinding{
+				"Date": dateBinding(),
+			},
+		}},
+		{"GenerateValidationPointers", "", []string{"OneOfInput.graphql"}, &Config{
+			GenerateValidation: true,
+			Optional:           "pointer",
+		}},
+		{"CopyDescriptions", "", []string{"SimpleQuery.graphql", "InterfaceNesting.graphql", "SimpleMutation.graphql"}, &Config{
+			CopyDescriptions: true,
+		}},
+		{"GenerateOneOfConstructors", "", []string{"OneOfInput.graphql"}, &Config{
+			GenerateOneOfConstructors: true,
+			GenerateValidation:        true,
+			Optional:                  "pointer",
+		}},
+		{"EmitProto", "", []string{"SimpleQuery.graphql", "SimpleNamedFragment.graphql", "QueryWithEnums.graphql"}, &Config{
+			EmitProto:      "proto",
+			ProtoGoPackage: "github.com/Khan/genqlient/generate/testdata/queries/proto",
+		}},
+		{"GenerateTextMarshalers", "", []string{"TypeNames.graphql"}, &Config{
+			GenerateTextMarshalers: true,
+		}},
+		{"GenerateFragmentInterfaces", "", []string{"ComplexNamedFragments.graphql", "OptionalFragment.graphql"}, &Config{
+			GenerateFragmentInterfaces: true,
+		}},
+		{"GenerateEnumValueLists", "", []string{"QueryWithEnums.graphql", "InputEnum.graphql"}, &Config{
+			GenerateEnumValueLists: true,
+		}},
+		{"GenerateComplexity", "", []string{"SimpleQuery.graphql", "ComplexNamedFragments.graphql", "SimpleMutation.graphql"}, &Config{
+			GenerateComplexity: true,
+		}},
+		{"GenerateWalk", "", []string{"ComplexNamedFragments.graphql", "InterfaceListOfListsOfListsField.graphql", "OptionalFragment.graphql", "ListOfListsOfLists.graphql"}, &Config{
+			GenerateWalk: true,
+			Optional:     "pointer",
+		}},
+		{"GenerateCompletenessCheck", "", []string{"ComplexNamedFragments.graphql", "InterfaceListOfListsOfListsField.graphql", "OptionalFragment.graphql", "QueryWithEnums.graphql", "ConditionalFields.graphql"}, &Config{
+			GenerateCompletenessCheck: true,
+			Optional:                  "pointer",
+		}},
+		{"BindingStringer", "", []string{"DateTime.graphql", "Pokemon.graphql"}, &Config{
+			Bindings: map[string]*TypeBinding{
+				"DateTime": {Type: "time.Time", Stringer: "method"},
+				"Pokemon": {
+					Type:              "github.com/Khan/genqlient/internal/testutil.Pokemon",
+					ExpectExactFields: "{ species level }",
+					Stringer:          "github.com/Khan/genqlient/internal/testutil.FormatPokemon",
+				},
+			},
+		}},
+		{"GenerateFakes", "", []string{"ComplexNamedFragments.graphql", "InterfaceListOfListsOfListsField.graphql", "OptionalFragment.graphql", "QueryWithEnums.graphql", "QueryWithSlices.graphql"}, &Config{
+			GenerateFakes: true,
+			Optional:      "pointer",
+		}},
+		{"GenerateUploadListers", "", []string{"UploadFiles.graphql", "SimpleQuery.graphql"}, &Config{
+			GenerateUploadListers: true,
+		}},
+		{"GenerateUploadListersPointers", "", []string{"UploadFiles.graphql"}, &Config{
+			GenerateUploadListers: true,
+			Optional:              "pointer",
+		}},
+		{"ExportPolicyUnexported", "", []string{
+			"InputEnum.graphql",
+			"SimpleNamedFragment.graphql",
+		}, &Config{
+			ExportPolicy: ExportPolicy{
+				Operations:  "unexported",
+				Fragments:   "unexported",
+				SchemaTypes: "unexported",
+			},
+		}},
+		{"ExportPolicyExported", "", []string{"unexported.graphql"}, &Config{
+			ExportPolicy: ExportPolicy{Operations: "exported"},
+			Bindings: map[string]*TypeBinding{
+				"Date": dateBinding(),
+			},
+		}},
+		{"TypenameAlways", "", []string{
+			"SimpleQuery.graphql",
+			"InterfaceNesting.graphql",
+			"SimpleNamedFragment.graphql",
+		}, &Config{
+			TypenameStrategy: "always",
+		}},
+		{"GenerateRepresentations", "", []string{"Entities.graphql"}, &Config{
+			GenerateRepresentations: true,
+		}},
+		{"GenerateRegistry", "", []string{
+			"SimpleQuery.graphql",
+			"SimpleMutation.graphql",
+			"unexported.graphql",
+		}, &Config{
+			GenerateRegistry: true,
+			Bindings: map[string]*TypeBinding{
+				"Date": dateBinding(),
+			},
+		}},
+		{"TimeLayout", "", []string{"DateTime.graphql", "InputObject.graphql"}, &Config{
+			Bindings: map[string]*TypeBinding{
+				"DateTime": {TimeLayout: "unix"},
+				"Date":     {TimeLayout: "2006-01-02"},
+			},
+		}},
+		{"OptionalConditionalFields", "", []string{"ConditionalFields.graphql"}, &Config{
+			OptionalConditionalFields: true,
+		}},
+		{"OptionalConditionalFieldsPointers", "", []string{"ConditionalFields.graphql"}, &Config{
+			OptionalConditionalFields: true,
+			Optional:                  "pointer",
+		}},
+		{"TypesOnly", "", nil, &Config{
+			TypesOnly: true,
+			Bindings: map[string]*TypeBinding{
+				"Date": dateBinding(),
+			},
+		}},
+		{"GeneratedHeader", "", nil, &Config{
+			GeneratedHeader: "// Code generated by make generate, DO NOT EDIT.\n// Source: SimpleQuery.graphql\n" +
+				"// Package: {{.Config.Package}}, operations: {{range .Operations}}{{.Name}} {{end}}\n",
+		}},
 	}
 
 	sourceFilename := "SimpleQuery.graphql"
@@ -292,6 +492,209 @@ func TestGenerateWithConfig(t *testing.T) {
 	}
 }
 
+// TestGenerateDeterministic checks that generation is byte-for-byte
+// deterministic, regardless of map iteration order or the order in which the
+// operation files are listed.
+func TestGenerateDeterministic(t *testing.T) {
+	operations := []string{
+		"ComplexNamedFragments.graphql",
+		"InputObject.graphql",
+		"InterfaceNesting.graphql",
+		"UnionNoFragments.graphql",
+	}
+
+	generate := func(operations []string) map[string][]byte {
+		config := &Config{
+			Generated:        "generated.go",
+			ExportOperations: "operations.json",
+			EmitCatalog:      "catalog.json",
+			Bindings: map[string]*TypeBinding{
+				"Date": dateBinding(),
+			},
+		}
+		err := config.ValidateAndFillDefaults(dataDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		config.Schema = []string{filepath.Join(dataDir, "schema.graphql")}
+		config.Operations = make([]string, len(operations))
+		for i, operation := range operations {
+			config.Operations[i] = filepath.Join(dataDir, operation)
+		}
+		generated, err := Generate(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return generated
+	}
+
+	expected := generate(operations)
+	reversed := make([]string, len(operations))
+	copy(reversed, operations)
+	reverse(reversed)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, expected, generate(operations))
+		assert.Equal(t, expected, generate(reversed))
+	}
+}
+
+func TestGeneratePlugins(t *testing.T) {
+	newConfig := func(plugins ...Plugin) *Config {
+		config := &Config{Generated: "generated.go", Plugins: plugins}
+		err := config.ValidateAndFillDefaults(dataDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		config.Schema = []string{filepath.Join(dataDir, "schema.graphql")}
+		config.Operations = []string{
+			filepath.Join(dataDir, "SimpleQuery.graphql"),
+			filepath.Join(dataDir, "SimpleMutation.graphql"),
+		}
+		return config
+	}
+
+	var order []string
+	listOperations := PluginFunc(func(ctx *GenContext) error {
+		order = append(order, "list")
+		// genqlient's own files are already there.
+		assert.Contains(t, ctx.Files, ctx.Config.Generated)
+		assert.NotNil(t, ctx.Schema.Types["User"])
+		var names []string
+		for _, op := range ctx.Document.Operations {
+			names = append(names, op.Name)
+		}
+		sort.Strings(names)
+		ctx.Files[ctx.Path("operations.txt")] = []byte(strings.Join(names, "\n"))
+		return nil
+	})
+	checkListed := PluginFunc(func(ctx *GenContext) error {
+		order = append(order, "check")
+		assert.Contains(t, ctx.Files, ctx.Path("operations.txt"))
+		return nil
+	})
+
+	generated, err := Generate(newConfig(listOperations, checkListed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"list", "check"}, order)
+	assert.Equal(t, "SimpleMutation\nSimpleQuery",
+		string(generated[filepath.Join(dataDir, "operations.txt")]))
+	assert.Contains(t, generated, filepath.Join(dataDir, "generated.go"))
+
+	failing := PluginFunc(func(ctx *GenContext) error { return errors.New("oh no") })
+	_, err = Generate(newConfig(failing))
+	assert.EqualError(t, err, "plugin generate.PluginFunc failed: oh no")
+}
+
+// TestGenerateFragmentsPackageSubset checks that a package using a
+// fragments_package needn't use all of its fragments.
+func TestGenerateFragmentsPackageSubset(t *testing.T) {
+	const sharedDir = "../internal/integration/sharedfragments"
+	operation := filepath.Join(t.TempDir(), "operation.graphql")
+	err := os.WriteFile(operation, []byte(
+		// (Uses SharedUserFields, but not SharedBeingFields.)
+		"query GetUser($id: ID!) { user(id: $id) { ...SharedUserFields } }"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := &Config{
+		Generated:        "generated.go",
+		FragmentsPackage: "github.com/Khan/genqlient/internal/integration/sharedfragments/fragments",
+	}
+	err = config.ValidateAndFillDefaults(dataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.Schema = []string{filepath.Join(sharedDir, "../schema.graphql")}
+	config.Operations = []string{
+		filepath.Join(sharedDir, "fragments/fragments.graphql"),
+		operation,
+	}
+
+	generated, err := Generate(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := generated[config.Generated]
+	assert.Contains(t, string(content), "fragments.SharedUserFields")
+
+	if testing.Short() {
+		t.Skip("skipping build due to -short")
+	}
+	err = buildGoFile("FragmentsPackageSubset", content)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// TestGenerateProtoStableNumbers checks that emit_proto keeps the numbers
+// of existing fields when the query changes.
+func TestGenerateProtoStableNumbers(t *testing.T) {
+	dir := t.TempDir()
+	operation := filepath.Join(dir, "operation.graphql")
+	generate := func(query string) string {
+		err := os.WriteFile(operation, []byte(query), 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		config := &Config{Generated: "generated.go", EmitProto: filepath.Join(dir, "proto")}
+		err = config.ValidateAndFillDefaults(dataDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		config.Schema = []string{filepath.Join(dataDir, "schema.graphql")}
+		config.Operations = []string{operation}
+		generated, err := Generate(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Write the .proto file, as genqlient would.
+		filename := filepath.Join(config.EmitProto, config.Package+".proto")
+		err = os.MkdirAll(config.EmitProto, 0o755)
+		if err == nil {
+			err = os.WriteFile(filename, generated[filename], 0o644)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Omit the comments, for brevity below.
+		var lines []string
+		for _, line := range strings.Split(string(generated[filename]), "\n") {
+			if !strings.HasPrefix(strings.TrimSpace(line), "//") {
+				lines = append(lines, line)
+			}
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	first := generate("query GetUser { user { id name emails roles } }")
+	assert.Contains(t, first, "  string id = 1;\n  string name = 2;\n"+
+		"  repeated string emails = 3;\n  repeated Role roles = 4;\n")
+
+	// Adding a field (at the start) gives it a new number; removing one
+	// reserves its number.
+	second := generate("query GetUser { user { emailsOrNull id emails roles } }")
+	assert.Contains(t, second, "  repeated string emailsOrNull = 5;\n  string id = 1;\n"+
+		"  repeated string emails = 3;\n  repeated Role roles = 4;\n  reserved 2;\n")
+
+	// ... and it stays reserved.
+	third := generate("query GetUser { user { id emails roles name } }")
+	assert.Contains(t, third, "  string id = 1;\n  repeated string emails = 3;\n"+
+		"  repeated Role roles = 4;\n  string name = 6;\n  reserved 2, 5;\n")
+}
+
+// errorTestConfigs holds, for each test in TestGenerateErrors whose error
+// only arises with some option enabled, a function which enables it.  (The
+// keys are test names, i.e. filenames sans extension.)
+var errorTestConfigs = map[string]func(*Config){
+	"FieldPathsConflict":                     func(c *Config) { c.GenerateFieldPaths = true },
+	"FieldPathsConflictWithOperation":        func(c *Config) { c.GenerateFieldPaths = true },
+	"FragmentInterfaceConflict":              func(c *Config) { c.GenerateFragmentInterfaces = true },
+	"FragmentInterfaceConflictWithOperation": func(c *Config) { c.GenerateFragmentInterfaces = true },
+}
+
 // TestGenerateErrors is a snapshot-based test of error text.
 //
 // For each .go or .graphql file in testdata/errors, it asserts that the given
@@ -331,7 +734,7 @@ func TestGenerateErrors(t *testing.T) {
 		}
 
 		t.Run(testFilename, func(t *testing.T) {
-			_, err := Generate(&Config{
+			config := &Config{
 				Schema:      []string{filepath.Join(errorsDir, schemaFilename)},
 				Operations:  []string{filepath.Join(errorsDir, sourceFilename)},
 				Package:     "test",
@@ -346,7 +749,11 @@ func TestGenerateErrors(t *testing.T) {
 					},
 				},
 				AllowBrokenFeatures: true,
-			})
+			}
+			if configure, ok := errorTestConfigs[baseFilename]; ok {
+				configure(config)
+			}
+			_, err := Generate(config)
 			if err == nil {
 				t.Fatal("expected an error")
 			}
@@ -2,7 +2,9 @@ package generate
 
 import (
 	"fmt"
+	"go/token"
 	"strings"
+	"time"
 
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/parser"
@@ -18,6 +20,28 @@ type genqlientDirective struct {
 	Flatten   *bool
 	Bind      string
 	TypeName  string
+	Endpoint  string
+	JSONTag   string
+	// FieldName, if set on a field, is the name of the Go struct field, in
+	// place of the one genqlient would choose from the field's alias.
+	FieldName string
+	// CacheTTL, if set on a query, is how long caching clients may cache
+	// its results, as a time.Duration string (see cachettl.go).
+	CacheTTL string
+	// SlicePointer, if set on a list-typed variable or field, makes its Go
+	// type a pointer to a slice, rather than a slice.
+	SlicePointer *bool
+	// FlattenInput, if set on an operation with a single input-object
+	// variable, makes the generated function take that input's fields as
+	// parameters (see flatteninput.go).
+	FlattenInput *bool
+	// Computed contains the methods to generate on the struct type for this
+	// field or fragment, each of the form "name: expression" (see
+	// computed.go).  Unlike other options, it may be given several times.
+	Computed []string
+	// OptionalFragment is the name of the variable which controls whether
+	// this fragment-spread is included (see optionalfragments.go).
+	OptionalFragment string
 	// FieldDirectives contains the directives to be
 	// applied to specific fields via the "for" option.
 	// Map from type-name -> field-name -> directive.
@@ -40,6 +64,9 @@ func (dir *genqlientDirective) argsString() string {
 	if dir.Pointer != nil {
 		parts = append(parts, fmt.Sprintf("pointer: %v", *dir.Pointer))
 	}
+	if dir.SlicePointer != nil {
+		parts = append(parts, fmt.Sprintf("slicePointer: %v", *dir.SlicePointer))
+	}
 	if dir.Struct != nil {
 		parts = append(parts, fmt.Sprintf("struct: %v", *dir.Struct))
 	}
@@ -52,14 +79,37 @@ func (dir *genqlientDirective) argsString() string {
 	if dir.TypeName != "" {
 		parts = append(parts, fmt.Sprintf("typename: %v", dir.TypeName))
 	}
+	if dir.Endpoint != "" {
+		parts = append(parts, fmt.Sprintf("endpoint: %v", dir.Endpoint))
+	}
+	if dir.JSONTag != "" {
+		parts = append(parts, fmt.Sprintf("jsonTag: %v", dir.JSONTag))
+	}
+	if dir.FieldName != "" {
+		parts = append(parts, fmt.Sprintf("fieldName: %v", dir.FieldName))
+	}
+	if dir.CacheTTL != "" {
+		parts = append(parts, fmt.Sprintf("cacheTTL: %v", dir.CacheTTL))
+	}
+	if dir.FlattenInput != nil {
+		parts = append(parts, fmt.Sprintf("flattenInput: %v", *dir.FlattenInput))
+	}
+	if dir.OptionalFragment != "" {
+		parts = append(parts, fmt.Sprintf("optionalFragment: %v", dir.OptionalFragment))
+	}
+	for _, computed := range dir.Computed {
+		parts = append(parts, fmt.Sprintf("computed: %v", computed))
+	}
 	return strings.Join(parts, ", ")
 }
 
 // String is useful for debugging.
 func (dir *genqlientDirective) String() string {
 	lines := []string{fmt.Sprintf("@genqlient(%s)", dir.argsString())}
-	for typeName, dirs := range dir.FieldDirectives {
-		for fieldName, fieldDir := range dirs {
+	for _, typeName := range sortedKeys(dir.FieldDirectives) {
+		dirs := dir.FieldDirectives[typeName]
+		for _, fieldName := range sortedKeys(dirs) {
+			fieldDir := dirs[fieldName]
 			lines = append(lines, fmt.Sprintf("@genqlient(for: %s.%s, %s)",
 				typeName, fieldName, fieldDir.argsString()))
 		}
@@ -72,6 +122,12 @@ func (dir *genqlientDirective) GetPointer() bool     { return dir.Pointer != nil
 func (dir *genqlientDirective) PointerIsFalse() bool { return dir.Pointer != nil && !*dir.Pointer }
 func (dir *genqlientDirective) GetStruct() bool      { return dir.Struct != nil && *dir.Struct }
 func (dir *genqlientDirective) GetFlatten() bool     { return dir.Flatten != nil && *dir.Flatten }
+func (dir *genqlientDirective) GetSlicePointer() bool {
+	return dir.SlicePointer != nil && *dir.SlicePointer
+}
+func (dir *genqlientDirective) GetFlattenInput() bool {
+	return dir.FlattenInput != nil && *dir.FlattenInput
+}
 
 func setBool(optionName string, dst **bool, v *ast.Value, pos *ast.Position) error {
 	if *dst != nil {
@@ -162,6 +218,8 @@ func (dir *genqlientDirective) add(graphQLDirective *ast.Directive, pos *ast.Pos
 			err = setBool("omitempty", &dir.Omitempty, arg.Value, pos)
 		case "pointer":
 			err = setBool("pointer", &dir.Pointer, arg.Value, pos)
+		case "slicePointer":
+			err = setBool("slicePointer", &dir.SlicePointer, arg.Value, pos)
 		case "struct":
 			err = setBool("struct", &dir.Struct, arg.Value, pos)
 		case "flatten":
@@ -170,6 +228,22 @@ func (dir *genqlientDirective) add(graphQLDirective *ast.Directive, pos *ast.Pos
 			err = setString("bind", &dir.Bind, arg.Value, pos)
 		case "typename":
 			err = setString("typename", &dir.TypeName, arg.Value, pos)
+		case "endpoint":
+			err = setString("endpoint", &dir.Endpoint, arg.Value, pos)
+		case "jsonTag":
+			err = setString("jsonTag", &dir.JSONTag, arg.Value, pos)
+		case "fieldName":
+			err = setString("fieldName", &dir.FieldName, arg.Value, pos)
+		case "cacheTTL":
+			err = setString("cacheTTL", &dir.CacheTTL, arg.Value, pos)
+		case "flattenInput":
+			err = setBool("flattenInput", &dir.FlattenInput, arg.Value, pos)
+		case "optionalFragment":
+			err = setString("optionalFragment", &dir.OptionalFragment, arg.Value, pos)
+		case "computed":
+			var computed string
+			err = setString("computed", &computed, arg.Value, pos)
+			dir.Computed = append(dir.Computed, computed)
 		case "for":
 			// handled above
 		default:
@@ -186,12 +260,14 @@ func (dir *genqlientDirective) add(graphQLDirective *ast.Directive, pos *ast.Pos
 func (dir *genqlientDirective) validate(node interface{}, schema *ast.Schema) error {
 	// TODO(benkraft): This function has a lot of duplicated checks, figure out
 	// how to organize them better to avoid the duplication.
-	for typeName, byField := range dir.FieldDirectives {
+	for _, typeName := range sortedKeys(dir.FieldDirectives) {
+		byField := dir.FieldDirectives[typeName]
 		typ, ok := schema.Types[typeName]
 		if !ok {
 			return errorf(dir.pos, `for got invalid type-name "%s"`, typeName)
 		}
-		for fieldName, fieldDir := range byField {
+		for _, fieldName := range sortedKeys(byField) {
+			fieldDir := byField[fieldName]
 			var field *ast.FieldDefinition
 			for _, typeField := range typ.Fields {
 				if typeField.Name == fieldName {
@@ -213,18 +289,97 @@ func (dir *genqlientDirective) validate(node interface{}, schema *ast.Schema) er
 				return errorf(fieldDir.pos, "struct and flatten can't be used via for")
 			}
 
+			if fieldDir.Endpoint != "" {
+				return errorf(fieldDir.pos, "endpoint is only applicable to operations")
+			}
+
+			if fieldDir.FlattenInput != nil {
+				return errorf(fieldDir.pos, "flattenInput is only applicable to operations")
+			}
+
+			if fieldDir.CacheTTL != "" {
+				return errorf(fieldDir.pos, "cacheTTL is only applicable to operations")
+			}
+
+			if fieldDir.OptionalFragment != "" {
+				return errorf(fieldDir.pos, "optionalFragment is only applicable to fragment spreads")
+			}
+
+			if len(fieldDir.Computed) > 0 {
+				return errorf(fieldDir.pos, "computed can't be used via for")
+			}
+
+			if fieldDir.FieldName != "" {
+				return errorf(fieldDir.pos, "fieldName can't be used via for")
+			}
+
 			if fieldDir.TypeName != "" && fieldDir.Bind != "" && fieldDir.Bind != "-" {
 				return errorf(fieldDir.pos, "typename and bind may not be used together")
 			}
 		}
 	}
 
+	if _, ok := node.(*ast.OperationDefinition); !ok && dir.Endpoint != "" {
+		return errorf(dir.pos, "endpoint is only applicable to operations")
+	}
+
+	// (A directive preceding an operation also precedes any variables
+	// declared on the operation's first line, so we allow flattenInput there;
+	// it's simply ignored.)
+	switch node.(type) {
+	case *ast.OperationDefinition, *ast.VariableDefinition:
+	default:
+		if dir.FlattenInput != nil {
+			return errorf(dir.pos, "flattenInput is only applicable to operations")
+		}
+		if dir.CacheTTL != "" {
+			return errorf(dir.pos, "cacheTTL is only applicable to operations")
+		}
+	}
+
+	if _, ok := node.(*ast.FragmentSpread); !ok && dir.OptionalFragment != "" {
+		return errorf(dir.pos, "optionalFragment is only applicable to fragment spreads")
+	}
+
+	if len(dir.Computed) > 0 {
+		var typ *ast.Definition
+		switch node := node.(type) {
+		case *ast.Field:
+			typ = schema.Types[node.Definition.Type.Name()]
+		case *ast.FragmentDefinition:
+			typ = schema.Types[node.TypeCondition]
+		default:
+			return errorf(dir.pos, "computed is only applicable to fields and fragment-definitions")
+		}
+		if typ == nil || typ.Kind != ast.Object {
+			return errorf(dir.pos, "computed is only applicable to object types")
+		}
+	}
+
 	switch node := node.(type) {
 	case *ast.OperationDefinition:
 		if dir.Bind != "" {
 			return errorf(dir.pos, "bind may not be applied to the entire operation")
 		}
 
+		if dir.JSONTag != "" {
+			return errorf(dir.pos, "jsonTag may not be applied to the entire operation")
+		}
+
+		if dir.FieldName != "" {
+			return errorf(dir.pos, "fieldName is only applicable to fields")
+		}
+
+		if dir.CacheTTL != "" {
+			if node.Operation != ast.Query {
+				return errorf(dir.pos, "cacheTTL may only be used on queries, not %ss", node.Operation)
+			}
+			if ttl, err := time.ParseDuration(dir.CacheTTL); err != nil || ttl <= 0 {
+				return errorf(dir.pos, "cacheTTL must be a positive duration, like \"60s\", got %q",
+					dir.CacheTTL)
+			}
+		}
+
 		// Anything else is valid on the entire operation; it will just apply
 		// to whatever it is relevant to.
 		return nil
@@ -238,6 +393,14 @@ func (dir *genqlientDirective) validate(node interface{}, schema *ast.Schema) er
 			return errorf(dir.pos, "struct is only applicable to fields, not frragment-definitions")
 		}
 
+		if dir.JSONTag != "" {
+			return errorf(dir.pos, "jsonTag is only applicable to fields and variables, not fragment-definitions")
+		}
+
+		if dir.FieldName != "" {
+			return errorf(dir.pos, "fieldName is only applicable to fields, not fragment-definitions")
+		}
+
 		// Like operations, anything else will just apply to the entire
 		// fragment.
 		return nil
@@ -254,6 +417,10 @@ func (dir *genqlientDirective) validate(node interface{}, schema *ast.Schema) er
 			return errorf(dir.pos, "flatten is only applicable to fields, not variable-definitions")
 		}
 
+		if dir.FieldName != "" {
+			return errorf(dir.pos, "fieldName is only applicable to fields, not variable-definitions")
+		}
+
 		if len(dir.FieldDirectives) > 0 {
 			return errorf(dir.pos, "for is only applicable to operations and arguments")
 		}
@@ -289,12 +456,61 @@ func (dir *genqlientDirective) validate(node interface{}, schema *ast.Schema) er
 			return errorf(dir.pos, "typename and bind may not be used together")
 		}
 
+		if dir.FieldName != "" && !(token.IsIdentifier(dir.FieldName) && token.IsExported(dir.FieldName)) {
+			return errorf(dir.pos, "fieldName must be an exported Go identifier, got %q", dir.FieldName)
+		}
+
+		return nil
+	case *ast.FragmentSpread:
+		if dir.Omitempty != nil || dir.Pointer != nil || dir.SlicePointer != nil ||
+			dir.Struct != nil || dir.Flatten != nil || dir.Bind != "" || dir.TypeName != "" ||
+			dir.JSONTag != "" || dir.FieldName != "" || len(dir.FieldDirectives) > 0 {
+			return errorf(dir.pos, "only optionalFragment is applicable to fragment spreads")
+		}
+
+		if !graphQLNameRegexp.MatchString(dir.OptionalFragment) {
+			return errorf(dir.pos, "optionalFragment must be a valid variable name, got %q",
+				dir.OptionalFragment)
+		}
+
+		// If the enclosing selection is abstract, the fragment's fields would
+		// end up in the interface's getters, which can't handle a missing
+		// fragment.  (Spread it within an inline fragment instead.)
+		if node.ObjectDefinition.Kind != ast.Object {
+			return errorf(dir.pos,
+				"optionalFragment may only be used in selections of object type, "+
+					"not %s %s", strings.ToLower(string(node.ObjectDefinition.Kind)),
+				node.ObjectDefinition.Name)
+		}
+
 		return nil
 	default:
 		return errorf(dir.pos, "invalid @genqlient directive location: %T", node)
 	}
 }
 
+// validateJSONTag checks that the given jsonTag option is a well-formed json
+// struct-tag for the field with the given JSON name.
+func validateJSONTag(tag, jsonName string, pos *ast.Position) error {
+	name, options, _ := strings.Cut(tag, ",")
+	if name != jsonName {
+		return errorf(pos, "jsonTag must begin with the field's JSON name %q, got %q",
+			jsonName, tag)
+	}
+	if options == "" {
+		return nil
+	}
+	for _, option := range strings.Split(options, ",") {
+		switch option {
+		case "omitempty", "string":
+		default:
+			return errorf(pos, "jsonTag has unknown option %q (valid options are omitempty and string)",
+				option)
+		}
+	}
+	return nil
+}
+
 func validateStructOption(
 	typ *ast.Definition,
 	selectionSet ast.SelectionSet,
@@ -355,6 +571,8 @@ func validateFlattenOption(
 		case *ast.FragmentSpread:
 			if index != -1 {
 				return -1, errorf(pos, "flatten is not allowed for fields with multiple selections")
+			} else if isOptionalFragment(selection) {
+				return -1, errorf(pos, "flatten may not be used with optionalFragment")
 			} else if !fragmentMatches(typ, selection.Definition.Definition) {
 				// We don't let you flatten
 				//  field { # type: FieldType
@@ -434,10 +652,13 @@ func (dir *genqlientDirective) mergeOperationDirective(
 	// directive wins over the operation directive.
 	fillDefaultBool(&dir.Omitempty, forField.Omitempty, operationDirective.Omitempty)
 	fillDefaultBool(&dir.Pointer, forField.Pointer, operationDirective.Pointer)
+	fillDefaultBool(&dir.SlicePointer, forField.SlicePointer, operationDirective.SlicePointer)
 	// struct and flatten aren't settable via "for".
 	fillDefaultBool(&dir.Struct, operationDirective.Struct)
 	fillDefaultBool(&dir.Flatten, operationDirective.Flatten)
 	fillDefaultString(&dir.Bind, forField.Bind, operationDirective.Bind)
+	// jsonTag isn't settable on the operation (it applies to a single field).
+	fillDefaultString(&dir.JSONTag, forField.JSONTag)
 	// typename isn't settable on the operation (when set there it replies to
 	// the response-type).
 	fillDefaultString(&dir.TypeName, forField.TypeName)
@@ -489,6 +710,8 @@ func (g *generator) parsePrecedingComment(
 				break
 			}
 		}
+		// We read the lines bottom-up, but want computed methods in source order.
+		reverse(directive.Computed)
 	}
 
 	if hasDirective { // (else directive is empty)
@@ -0,0 +1,40 @@
+package generate
+
+import (
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// runPostGenerateHooks runs each of config.PostGenerateHooks on each of the
+// given (already written) files which is a Go file, in order.  Each hook is
+// a command and its arguments, separated by whitespace, to which we append
+// the filename; it's run in the directory of the config file.
+func runPostGenerateHooks(config *Config, filenames []string) error {
+	if len(config.PostGenerateHooks) == 0 {
+		return nil
+	}
+
+	var goFiles []string
+	for _, filename := range filenames {
+		if filepath.Ext(filename) == ".go" {
+			goFiles = append(goFiles, filename)
+		}
+	}
+	sort.Strings(goFiles)
+
+	for _, filename := range goFiles {
+		for _, hook := range config.PostGenerateHooks {
+			args := append(strings.Fields(hook), filename)
+			cmd := exec.Command(args[0], args[1:]...)
+			cmd.Dir = config.baseDir
+			output, err := cmd.CombinedOutput()
+			if err != nil {
+				return errorf(nil, "post-generate hook %q failed on %v: %v\n%s",
+					hook, filename, err, output)
+			}
+		}
+	}
+	return nil
+}
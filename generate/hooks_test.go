@@ -0,0 +1,41 @@
+package generate
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunPostGenerateHooks(t *testing.T) {
+	if _, err := exec.LookPath("gofmt"); err != nil {
+		t.Skip("gofmt not found:", err)
+	}
+
+	dir := t.TempDir()
+	goFile := filepath.Join(dir, "generated.go")
+	jsonFile := filepath.Join(dir, "catalog.json")
+	require.NoError(t, os.WriteFile(goFile, []byte("package p\nvar  x  =  1\n"), 0o644))
+	require.NoError(t, os.WriteFile(jsonFile, []byte("{ }\n"), 0o644))
+
+	config := &Config{baseDir: dir, PostGenerateHooks: []string{"gofmt -w"}}
+	err := runPostGenerateHooks(config, []string{goFile, jsonFile})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(goFile)
+	require.NoError(t, err)
+	assert.Equal(t, "package p\n\nvar x = 1\n", string(content))
+
+	// Non-Go files are left alone.
+	content, err = os.ReadFile(jsonFile)
+	require.NoError(t, err)
+	assert.Equal(t, "{ }\n", string(content))
+
+	config.PostGenerateHooks = []string{"gofmt -l", "gofmt -badflag"}
+	err = runPostGenerateHooks(config, []string{goFile})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `post-generate hook "gofmt -badflag" failed`)
+}
@@ -122,7 +122,8 @@ func (g *generator) Imports() string {
 
 	var builder strings.Builder
 	builder.WriteString("import (\n")
-	for path, alias := range g.imports {
+	for _, path := range sortedKeys(g.imports) {
+		alias := g.imports[path]
 		if path == alias || strings.HasSuffix(path, "/"+alias) {
 			builder.WriteString("\t" + strconv.Quote(path) + "\n")
 		} else {
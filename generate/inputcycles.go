@@ -0,0 +1,107 @@
+package generate
+
+// This file breaks cycles among input types.  GraphQL allows an input type
+// to refer to itself (directly or indirectly) via a nullable field, such as
+//	input BoolExp { _not: BoolExp, ... }
+// but in Go a struct can't contain itself by value.  So once we've converted
+// all the types, we look for such cycles, and change one field in each to a
+// pointer, preferably a nullable one.  (Cycles via lists are fine: slices are
+// already references.)
+
+// breakInputCycles finds each cycle of input-type struct fields which
+// contain each other by value, and makes one field in it a pointer.
+func (g *generator) breakInputCycles() {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[*goStructType]int{}
+	// The structs we're visiting, outermost first, and the field of each by
+	// which we reached the next.
+	var stack []*goStructType
+	var path []*goStructField
+
+	var visit func(typ *goStructType)
+	visit = func(typ *goStructType) {
+		state[typ] = visiting
+		stack = append(stack, typ)
+		for _, field := range typ.Fields {
+			elem := valueInputStruct(field.GoType)
+			if elem == nil {
+				continue
+			}
+			switch state[elem] {
+			case unvisited:
+				path = append(path, field)
+				visit(elem)
+				path = path[:len(path)-1]
+			case visiting:
+				// field closes a cycle, from elem back to elem; break it.
+				i := len(stack) - 1
+				for stack[i] != elem {
+					i--
+				}
+				cycle := append(append([]*goStructField{}, path[i:]...), field)
+				breakCycle(cycle)
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[typ] = visited
+	}
+
+	// Sort to make sure we always break the same field of each cycle.
+	for _, name := range sortedKeys(g.typeMap) {
+		typ, ok := g.typeMap[name].(*goStructType)
+		if ok && typ.IsInput && state[typ] == unvisited {
+			visit(typ)
+		}
+	}
+}
+
+// breakCycle makes one of the given fields, which form a cycle, a pointer,
+// unless one already is.  We prefer the last nullable field (GraphQL
+// requires each cycle to have one), so that non-null fields stay values,
+// and otherwise the last field, which closed the cycle.
+func breakCycle(cycle []*goStructField) {
+	toBreak := cycle[len(cycle)-1]
+	for i := len(cycle) - 1; i >= 0; i-- {
+		if valueInputStruct(cycle[i].GoType) == nil {
+			return // we already broke this cycle, via another
+		}
+	}
+	for i := len(cycle) - 1; i >= 0; i-- {
+		if cycle[i].GraphQLType != nil && !cycle[i].GraphQLType.NonNull {
+			toBreak = cycle[i]
+			break
+		}
+	}
+	toBreak.GoType = pointerTo(toBreak.GoType)
+}
+
+// valueInputStruct returns the input-type struct which a field of type typ
+// contains by value, if any.
+func valueInputStruct(typ goType) *goStructType {
+	if generic, ok := typ.(*goGenericType); ok {
+		// We assume the generic type holds its element by value, as does
+		// (say) a typical Option[T].
+		typ = generic.Elem
+	}
+	structType, ok := typ.(*goStructType)
+	if !ok || !structType.IsInput {
+		return nil
+	}
+	return structType
+}
+
+// pointerTo returns typ, with the struct it contains by value (see
+// valueInputStruct) replaced by a pointer to the same.
+func pointerTo(typ goType) goType {
+	if generic, ok := typ.(*goGenericType); ok {
+		return &goGenericType{
+			GoGenericRef: generic.GoGenericRef,
+			Elem:         &goPointerType{Elem: generic.Elem},
+		}
+	}
+	return &goPointerType{Elem: typ}
+}
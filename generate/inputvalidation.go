@@ -0,0 +1,367 @@
+package generate
+
+// This file generates the Validate methods on input types enabled by the
+// generate_validation option.  They check the constraints of the GraphQL
+// input type which can be checked client-side:
+//   - non-null fields must be set (if the Go type can represent "unset", i.e.
+//     it's a pointer or slice),
+//   - enum values must be valid, and
+//   - input types with @oneOf must have exactly one field set, and
+//   - fields must satisfy their @constraint directives, if any (see
+//     constraintChecks for which arguments we support),
+// recursively into nested input types.
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// zeroValues are the zero values of the builtin types, for use in checking
+// whether a non-nillable field is set.
+var zeroValues = map[string]string{
+	"string":  `""`,
+	"int":     "0",
+	"float64": "0",
+	"bool":    "false",
+}
+
+// writeValidate writes the Validate method for an input type.
+func (typ *goStructType) writeValidate(w io.Writer, g *generator) error {
+	errorfRef, err := g.ref("fmt.Errorf")
+	if err != nil {
+		return err
+	}
+
+	var body strings.Builder
+	for _, field := range typ.Fields {
+		path := typ.GraphQLName + "." + field.GraphQLName
+		checks, err := constraintChecks(g, typ, field)
+		if err != nil {
+			return err
+		}
+		writeValidateValue(&body, errorfRef, "v."+field.GoName, field.GoType, field.GraphQLType,
+			checks, !field.GraphQLType.NonNull, path, 0)
+	}
+
+	if typ.OneOf {
+		body.WriteString("set := 0\n")
+		for _, field := range typ.Fields {
+			isSet, err := isSetExpr(g, "v."+field.GoName, field.GoType)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(&body, "if %s { set++ }\n", isSet)
+		}
+		fmt.Fprintf(&body,
+			"if set != 1 { return %s(\"%s: exactly one field must be set (@oneOf), got %%v\", set) }\n",
+			errorfRef, typ.GraphQLName)
+	}
+
+	writeDescription(w, fmt.Sprintf(
+		"Validate checks that the %s satisfies the constraints of the GraphQL type %s "+
+			"which can be checked client-side, and returns an error if not.",
+		typ.GoName, typ.GraphQLName))
+	fmt.Fprintf(w, "func (v *%s) Validate() error {\n%sreturn nil\n}\n", typ.GoName, body.String())
+	return nil
+}
+
+// writeValidateValue writes the code to validate the Go expression expr, of
+// the given Go and GraphQL types.  checks are the @constraint checks to apply
+// to the scalar value(s), and zeroIsUnset is true if the zero value of expr
+// means the field is unset, in which case we don't check it.  depth is used
+// to name loop variables.
+func writeValidateValue(
+	w io.Writer,
+	errorfRef string,
+	expr string,
+	goTyp goType,
+	graphQLType *ast.Type,
+	checks []constraintCheck,
+	zeroIsUnset bool,
+	path string,
+	depth int,
+) {
+	switch goTyp := goTyp.(type) {
+	case *goPointerType:
+		if graphQLType.NonNull {
+			fmt.Fprintf(w, "if %s == nil { return %s(\"%s is required\") }\n",
+				expr, errorfRef, path)
+		}
+		var inner strings.Builder
+		// Input structs have pointer-receiver methods, so need no deref.
+		innerExpr := "*" + expr
+		if _, ok := goTyp.Elem.(*goStructType); ok {
+			innerExpr = expr
+		}
+		writeValidateValue(&inner, errorfRef, innerExpr, goTyp.Elem, graphQLType,
+			checks, false, path, depth)
+		if inner.Len() > 0 {
+			fmt.Fprintf(w, "if %s != nil {\n%s}\n", expr, inner.String())
+		}
+
+	case *goSliceType:
+		if graphQLType.NonNull {
+			fmt.Fprintf(w, "if %s == nil { return %s(\"%s is required\") }\n",
+				expr, errorfRef, path)
+		}
+		if graphQLType.Elem == nil {
+			return // (can't happen, but just in case)
+		}
+		elem := fmt.Sprintf("e%d", depth)
+		var inner strings.Builder
+		writeValidateValue(&inner, errorfRef, elem, goTyp.Elem, graphQLType.Elem,
+			checks, !graphQLType.Elem.NonNull, path+"[]", depth+1)
+		if inner.Len() > 0 {
+			fmt.Fprintf(w, "for _, %s := range %s {\n%s}\n", elem, expr, inner.String())
+		}
+
+	case *goEnumType:
+		values := make([]string, len(goTyp.Values))
+		for i, val := range goTyp.Values {
+			values[i] = val.GoName
+		}
+		if !graphQLType.NonNull {
+			// For nullable fields represented as values, the zero value is
+			// how you say "unset".
+			values = append(values, `""`)
+		}
+		fmt.Fprintf(w, "switch %s {\ncase %s:\ndefault:\n"+
+			"return %s(\"%s: invalid %s value %%q\", %s)\n}\n",
+			expr, strings.Join(values, ", "), errorfRef,
+			path, goTyp.GraphQLName, expr)
+
+	case *goStructType:
+		if goTyp.IsInput {
+			fmt.Fprintf(w, "if err := %s.Validate(); err != nil { return %s(\"%s: %%w\", err) }\n",
+				expr, errorfRef, path)
+		}
+
+	case *goOpaqueType:
+		if len(checks) == 0 {
+			return
+		}
+		var inner strings.Builder
+		for _, check := range checks {
+			fmt.Fprintf(&inner, "if %s { return %s(%q, %s) }\n",
+				check.failed(expr), errorfRef,
+				path+": "+strings.ReplaceAll(check.message, "%", "%%")+", got "+check.verb, expr)
+		}
+		if zero, ok := zeroValues[goTyp.GoRef]; ok && zeroIsUnset {
+			fmt.Fprintf(w, "if %s != %s {\n%s}\n", expr, zero, inner.String())
+		} else {
+			fmt.Fprint(w, inner.String())
+		}
+
+	default:
+		// Other scalars can't be checked client-side, and generic types are
+		// opaque to us.
+	}
+}
+
+// A constraintCheck is a check generated from an @constraint directive.
+type constraintCheck struct {
+	// failed returns a Go expression which is true if the scalar value expr
+	// violates the constraint.
+	failed func(expr string) string
+	// message describes the constraint, e.g. "must be at least 3", and verb
+	// is the format-verb with which to print the value.
+	message, verb string
+}
+
+// constraintChecks returns the checks for the @constraint directive on the
+// given input field, if any, in the style of graphql-constraint-directive:
+//
+//	input SignupInput {
+//	  name: String! @constraint(minLength: 1, maxLength: 50)
+//	}
+//
+// We support the arguments minLength, maxLength, startsWith, endsWith,
+// contains, notContains, and pattern, on string fields; and min, max,
+// exclusiveMin, and exclusiveMax, on numeric fields.  We skip other
+// arguments (like format), and arguments on fields of other Go types (e.g.
+// custom scalars), with a warning.
+func constraintChecks(g *generator, typ *goStructType, field *goStructField) ([]constraintCheck, error) {
+	def := g.schema.Types[typ.GraphQLName]
+	if def == nil {
+		return nil, nil
+	}
+	fieldDef := def.Fields.ForName(field.GraphQLName)
+	if fieldDef == nil {
+		return nil, nil
+	}
+	directive := fieldDef.Directives.ForName("constraint")
+	if directive == nil {
+		return nil, nil
+	}
+
+	// Find the Go type of the scalar value(s) of the field.
+	scalarType := field.GoType
+	for {
+		if ptr, ok := scalarType.(*goPointerType); ok {
+			scalarType = ptr.Elem
+		} else if slice, ok := scalarType.(*goSliceType); ok {
+			scalarType = slice.Elem
+		} else {
+			break
+		}
+	}
+	var goRef string
+	if opaque, ok := scalarType.(*goOpaqueType); ok {
+		goRef = opaque.GoRef
+	}
+	isString := goRef == "string"
+	isNumber := false
+	switch goRef {
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		isNumber = true
+	}
+
+	var checks []constraintCheck
+	for _, arg := range directive.Arguments {
+		var check *constraintCheck
+		var err error
+		switch {
+		case isString:
+			check, err = stringConstraintCheck(g, arg)
+		case isNumber:
+			check = numberConstraintCheck(goRef, arg)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if check == nil {
+			warn(errorf(arg.Position, "warning: skipping unsupported @constraint(%s) on %s.%s",
+				arg.Name, typ.GraphQLName, field.GraphQLName))
+			continue
+		}
+		checks = append(checks, *check)
+	}
+	return checks, nil
+}
+
+// stringConstraintCheck returns the check for the given @constraint argument
+// on a string field, or nil if it's not supported.
+func stringConstraintCheck(g *generator, arg *ast.Argument) (*constraintCheck, error) {
+	raw := arg.Value.Raw
+	switch arg.Name {
+	case "minLength", "maxLength":
+		if arg.Value.Kind != ast.IntValue {
+			return nil, nil
+		}
+		runeCount, err := g.ref("unicode/utf8.RuneCountInString")
+		if err != nil {
+			return nil, err
+		}
+		op, message := "<", "must have at least "+raw+" characters"
+		if arg.Name == "maxLength" {
+			op, message = ">", "must have at most "+raw+" characters"
+		}
+		return &constraintCheck{
+			failed:  func(expr string) string { return fmt.Sprintf("%s(%s) %s %s", runeCount, expr, op, raw) },
+			message: message,
+			verb:    "%q",
+		}, nil
+	case "startsWith", "endsWith", "contains", "notContains":
+		if arg.Value.Kind != ast.StringValue {
+			return nil, nil
+		}
+		funcName, negate, message := "HasPrefix", "!", "must start with "
+		switch arg.Name {
+		case "endsWith":
+			funcName, message = "HasSuffix", "must end with "
+		case "contains":
+			funcName, message = "Contains", "must contain "
+		case "notContains":
+			funcName, negate, message = "Contains", "", "must not contain "
+		}
+		f, err := g.ref("strings." + funcName)
+		if err != nil {
+			return nil, err
+		}
+		return &constraintCheck{
+			failed:  func(expr string) string { return fmt.Sprintf("%s%s(%s, %q)", negate, f, expr, raw) },
+			message: message + strconv.Quote(raw),
+			verb:    "%q",
+		}, nil
+	case "pattern":
+		if arg.Value.Kind != ast.StringValue {
+			return nil, nil
+		}
+		if _, err := regexp.Compile(raw); err != nil {
+			// (e.g. a JavaScript regexp which Go doesn't support)
+			return nil, nil
+		}
+		mustCompile, err := g.ref("regexp.MustCompile")
+		if err != nil {
+			return nil, err
+		}
+		return &constraintCheck{
+			failed: func(expr string) string {
+				return fmt.Sprintf("!%s(%q).MatchString(%s)", mustCompile, raw, expr)
+			},
+			message: "must match " + strconv.Quote(raw),
+			verb:    "%q",
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// numberConstraintCheck returns the check for the given @constraint argument
+// on a numeric field of Go type goRef, or nil if it's not supported.
+func numberConstraintCheck(goRef string, arg *ast.Argument) *constraintCheck {
+	if arg.Value.Kind != ast.IntValue && arg.Value.Kind != ast.FloatValue {
+		return nil
+	}
+	raw := arg.Value.Raw
+	var op, message string
+	switch arg.Name {
+	case "min":
+		op, message = "<", "must be at least "+raw
+	case "max":
+		op, message = ">", "must be at most "+raw
+	case "exclusiveMin":
+		op, message = "<=", "must be greater than "+raw
+	case "exclusiveMax":
+		op, message = ">=", "must be less than "+raw
+	default:
+		return nil
+	}
+	// Compare integers to non-integral bounds, and unsigned integers to
+	// negative ones, as floats, so the generated code compiles.
+	convert := (arg.Value.Kind == ast.FloatValue && !strings.HasPrefix(goRef, "float")) ||
+		(strings.HasPrefix(goRef, "uint") && strings.HasPrefix(raw, "-"))
+	return &constraintCheck{
+		failed: func(expr string) string {
+			if convert {
+				expr = "float64(" + expr + ")"
+			}
+			return fmt.Sprintf("%s %s %s", expr, op, raw)
+		},
+		message: message,
+		verb:    "%v",
+	}
+}
+
+// isSetExpr returns a Go expression which is true if the given Go
+// expression, of the given type, is set (i.e. is not nil or the zero value).
+func isSetExpr(g *generator, expr string, goTyp goType) (string, error) {
+	switch goTyp := goTyp.(type) {
+	case *goPointerType, *goSliceType:
+		return expr + " != nil", nil
+	case *goEnumType:
+		return expr + ` != ""`, nil
+	case *goOpaqueType:
+		if zero, ok := zeroValues[goTyp.GoRef]; ok {
+			return expr + " != " + zero, nil
+		}
+	}
+	valueOf, err := g.ref("reflect.ValueOf")
+	return fmt.Sprintf("!%s(%s).IsZero()", valueOf, expr), err
+}
@@ -0,0 +1,290 @@
+package generate
+
+// This file fetches a schema from a live endpoint via introspection, for
+// `genqlient diff` (see diff.go).  We convert the introspection result to
+// SDL, and load that the same way we load schema files.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+const introspectionQuery = `
+query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types {
+      kind
+      name
+      fields(includeDeprecated: true) {
+        name
+        args { ...InputValue }
+        type { ...TypeRef }
+      }
+      inputFields { ...InputValue }
+      interfaces { ...TypeRef }
+      enumValues(includeDeprecated: true) { name }
+      possibleTypes { ...TypeRef }
+    }
+    directives {
+      name
+      locations
+      args { ...InputValue }
+    }
+  }
+}
+
+fragment InputValue on __InputValue {
+  name
+  type { ...TypeRef }
+  defaultValue
+}
+
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType {
+    kind
+    name
+    ofType {
+      kind
+      name
+      ofType {
+        kind
+        name
+        ofType {
+          kind
+          name
+          ofType {
+            kind
+            name
+            ofType {
+              kind
+              name
+              ofType { kind name }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+// The following types are the subset of the introspection result we use.
+type introspectionSchema struct {
+	QueryType        *introspectionTypeRef `json:"queryType"`
+	MutationType     *introspectionTypeRef `json:"mutationType"`
+	SubscriptionType *introspectionTypeRef `json:"subscriptionType"`
+	Types            []*introspectionType  `json:"types"`
+	Directives       []*struct {
+		Name      string                     `json:"name"`
+		Locations []string                   `json:"locations"`
+		Args      []*introspectionInputValue `json:"args"`
+	} `json:"directives"`
+}
+
+type introspectionType struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Fields []*struct {
+		Name string                     `json:"name"`
+		Args []*introspectionInputValue `json:"args"`
+		Type *introspectionTypeRef      `json:"type"`
+	} `json:"fields"`
+	InputFields   []*introspectionInputValue `json:"inputFields"`
+	Interfaces    []*introspectionTypeRef    `json:"interfaces"`
+	EnumValues    []*struct{ Name string }   `json:"enumValues"`
+	PossibleTypes []*introspectionTypeRef    `json:"possibleTypes"`
+}
+
+type introspectionInputValue struct {
+	Name         string                `json:"name"`
+	Type         *introspectionTypeRef `json:"type"`
+	DefaultValue *string               `json:"defaultValue"`
+}
+
+type introspectionTypeRef struct {
+	Kind   string                `json:"kind"`
+	Name   string                `json:"name"`
+	OfType *introspectionTypeRef `json:"ofType"`
+}
+
+// String returns the type in GraphQL syntax, e.g. "[String!]".
+func (ref *introspectionTypeRef) String() string {
+	switch ref.Kind {
+	case "NON_NULL":
+		return ref.OfType.String() + "!"
+	case "LIST":
+		return "[" + ref.OfType.String() + "]"
+	default:
+		return ref.Name
+	}
+}
+
+// fetchSchema fetches the schema of the given GraphQL endpoint, sending the
+// given HTTP headers, via introspection.
+func fetchSchema(ctx context.Context, endpoint string, header http.Header) (*ast.Schema, error) {
+	body, err := json.Marshal(map[string]string{"query": introspectionQuery})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, errorf(nil, "invalid endpoint %v: %v", endpoint, err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errorf(nil, "could not fetch schema from %v: %v", endpoint, err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errorf(nil, "could not read schema from %v: %v", endpoint, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errorf(nil, "could not fetch schema from %v: returned %v: %s",
+			endpoint, resp.Status, respBody)
+	}
+
+	var result struct {
+		Data *struct {
+			Schema *introspectionSchema `json:"__schema"`
+		} `json:"data"`
+		Errors []struct{ Message string } `json:"errors"`
+	}
+	err = json.Unmarshal(respBody, &result)
+	if err != nil {
+		return nil, errorf(nil, "invalid introspection response from %v: %v", endpoint, err)
+	}
+	if len(result.Errors) > 0 {
+		return nil, errorf(nil, "introspection query to %v failed: %v",
+			endpoint, result.Errors[0].Message)
+	}
+	if result.Data == nil || result.Data.Schema == nil {
+		return nil, errorf(nil, "introspection response from %v has no schema", endpoint)
+	}
+
+	return loadSchema([]*ast.Source{{
+		Name:  endpoint,
+		Input: introspectionSDL(result.Data.Schema),
+	}})
+}
+
+// introspectionSDL returns the given introspection result as SDL (omitting
+// descriptions and deprecations, which don't affect validity).
+func introspectionSDL(schema *introspectionSchema) string {
+	var b strings.Builder
+	b.WriteString("schema {\n")
+	if schema.QueryType != nil {
+		fmt.Fprintf(&b, "  query: %s\n", schema.QueryType.Name)
+	}
+	if schema.MutationType != nil {
+		fmt.Fprintf(&b, "  mutation: %s\n", schema.MutationType.Name)
+	}
+	if schema.SubscriptionType != nil {
+		fmt.Fprintf(&b, "  subscription: %s\n", schema.SubscriptionType.Name)
+	}
+	b.WriteString("}\n")
+
+	for _, typ := range schema.Types {
+		if strings.HasPrefix(typ.Name, "__") || isBuiltinScalar(typ.Name) {
+			continue // gqlparser's prelude adds these
+		}
+		switch typ.Kind {
+		case "SCALAR":
+			fmt.Fprintf(&b, "\nscalar %s\n", typ.Name)
+		case "OBJECT", "INTERFACE":
+			keyword := "type"
+			if typ.Kind == "INTERFACE" {
+				keyword = "interface"
+			}
+			fmt.Fprintf(&b, "\n%s %s", keyword, typ.Name)
+			for i, iface := range typ.Interfaces {
+				if i == 0 {
+					b.WriteString(" implements ")
+				} else {
+					b.WriteString(" & ")
+				}
+				b.WriteString(iface.Name)
+			}
+			b.WriteString(" {\n")
+			for _, field := range typ.Fields {
+				fmt.Fprintf(&b, "  %s%s: %s\n",
+					field.Name, introspectionArgsSDL(field.Args), field.Type)
+			}
+			b.WriteString("}\n")
+		case "UNION":
+			members := make([]string, len(typ.PossibleTypes))
+			for i, member := range typ.PossibleTypes {
+				members[i] = member.Name
+			}
+			fmt.Fprintf(&b, "\nunion %s = %s\n", typ.Name, strings.Join(members, " | "))
+		case "ENUM":
+			fmt.Fprintf(&b, "\nenum %s {\n", typ.Name)
+			for _, val := range typ.EnumValues {
+				fmt.Fprintf(&b, "  %s\n", val.Name)
+			}
+			b.WriteString("}\n")
+		case "INPUT_OBJECT":
+			fmt.Fprintf(&b, "\ninput %s {\n", typ.Name)
+			for _, field := range typ.InputFields {
+				fmt.Fprintf(&b, "  %s\n", introspectionInputValueSDL(field))
+			}
+			b.WriteString("}\n")
+		}
+	}
+
+	// gqlparser's prelude also adds the builtin directives.
+	prelude, _ := parser.ParseSchema(validator.Prelude)
+	for _, dir := range schema.Directives {
+		if prelude != nil && prelude.Directives.ForName(dir.Name) != nil {
+			continue
+		}
+		fmt.Fprintf(&b, "\ndirective @%s%s on %s\n",
+			dir.Name, introspectionArgsSDL(dir.Args), strings.Join(dir.Locations, " | "))
+	}
+	return b.String()
+}
+
+func introspectionArgsSDL(args []*introspectionInputValue) string {
+	if len(args) == 0 {
+		return ""
+	}
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		parts[i] = introspectionInputValueSDL(arg)
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+func introspectionInputValueSDL(val *introspectionInputValue) string {
+	if val.DefaultValue != nil {
+		return fmt.Sprintf("%s: %s = %s", val.Name, val.Type, *val.DefaultValue)
+	}
+	return fmt.Sprintf("%s: %s", val.Name, val.Type)
+}
+
+func isBuiltinScalar(name string) bool {
+	switch name {
+	case "String", "Int", "Float", "Boolean", "ID":
+		return true
+	}
+	return false
+}
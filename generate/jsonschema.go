@@ -0,0 +1,218 @@
+package generate
+
+// This file generates the JSON Schemas enabled by the
+// emit_variables_jsonschema option: for each operation, a JSON Schema
+// describing its variables, for use by (for example) form generators and
+// frontend validation.
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchema is the subset of JSON Schema we use.
+type jsonSchema struct {
+	Schema      string `json:"$schema,omitempty"`
+	Ref         string `json:"$ref,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Either a string, or (for nullable types) a []string.
+	Type                 interface{}            `json:"type,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	Properties           jsonSchemaProperties   `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+	MinProperties        *int                   `json:"minProperties,omitempty"`
+	MaxProperties        *int                   `json:"maxProperties,omitempty"`
+	AnyOf                []*jsonSchema          `json:"anyOf,omitempty"`
+	Default              interface{}            `json:"default,omitempty"`
+	Defs                 map[string]*jsonSchema `json:"$defs,omitempty"`
+}
+
+// jsonSchemaProperties is the properties of an object schema, which we keep
+// in order (of the variables in the operation, or the fields in the input
+// type), since form generators typically present them in that order.
+type jsonSchemaProperties []*jsonSchemaProperty
+
+type jsonSchemaProperty struct {
+	Name   string
+	Schema *jsonSchema
+}
+
+func (props jsonSchemaProperties) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, prop := range props {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(prop.Name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		schema, err := json.Marshal(prop.Schema)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(schema)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// jsonSchemaBuilder builds the schema for the variables of one operation; it
+// collects the schemas of input-object and enum types in defs, so that
+// (possibly recursive) types can refer to each other.
+type jsonSchemaBuilder struct {
+	schema *ast.Schema
+	defs   map[string]*jsonSchema
+}
+
+// variablesJSONSchemaFor returns the JSON Schema for the variables of the
+// given operation.
+func variablesJSONSchemaFor(
+	schema *ast.Schema,
+	op *ast.OperationDefinition,
+	description string,
+) (*jsonSchema, error) {
+	b := &jsonSchemaBuilder{schema: schema, defs: map[string]*jsonSchema{}}
+	properties := make(jsonSchemaProperties, len(op.VariableDefinitions))
+	var required []string
+	for i, def := range op.VariableDefinitions {
+		prop, err := b.typeSchema(def.Type, def.Position)
+		if err != nil {
+			return nil, err
+		}
+		if def.DefaultValue != nil {
+			prop.Default, err = def.DefaultValue.Value(nil)
+			if err != nil {
+				return nil, errorf(def.Position, "invalid default value: %v", err)
+			}
+		} else if def.Type.NonNull {
+			required = append(required, def.Variable)
+		}
+		properties[i] = &jsonSchemaProperty{Name: def.Variable, Schema: prop}
+	}
+
+	closed := false
+	retval := &jsonSchema{
+		Schema:               jsonSchemaDialect,
+		Title:                op.Name,
+		Description:          description,
+		Type:                 "object",
+		Properties:           properties,
+		Required:             required,
+		AdditionalProperties: &closed,
+	}
+	if len(b.defs) > 0 {
+		retval.Defs = b.defs
+	}
+	return retval, nil
+}
+
+// typeSchema returns the schema for a value of the given GraphQL type.
+func (b *jsonSchemaBuilder) typeSchema(typ *ast.Type, pos *ast.Position) (*jsonSchema, error) {
+	var retval *jsonSchema
+	if typ.Elem != nil {
+		elem, err := b.typeSchema(typ.Elem, pos)
+		if err != nil {
+			return nil, err
+		}
+		retval = &jsonSchema{Type: "array", Items: elem}
+	} else {
+		def := b.schema.Types[typ.NamedType]
+		if def == nil {
+			return nil, errorf(pos, "unknown type %s", typ.NamedType)
+		}
+		var err error
+		retval, err = b.definitionSchema(def)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if typ.NonNull {
+		return retval, nil
+	}
+	if t, ok := retval.Type.(string); ok {
+		retval.Type = []string{t, "null"}
+		return retval, nil
+	}
+	return &jsonSchema{AnyOf: []*jsonSchema{retval, {Type: "null"}}}, nil
+}
+
+// definitionSchema returns the schema for a value of the given named GraphQL
+// type (which, for input-objects and enums, is a reference to its
+// definition in b.defs).
+func (b *jsonSchemaBuilder) definitionSchema(def *ast.Definition) (*jsonSchema, error) {
+	switch def.Kind {
+	case ast.Scalar:
+		switch def.Name {
+		case "Int":
+			return &jsonSchema{Type: "integer"}, nil
+		case "Float":
+			return &jsonSchema{Type: "number"}, nil
+		case "String", "ID":
+			return &jsonSchema{Type: "string"}, nil
+		case "Boolean":
+			return &jsonSchema{Type: "boolean"}, nil
+		default:
+			// We don't know the JSON representation of custom scalars, so
+			// allow anything.
+			return &jsonSchema{Title: def.Name}, nil
+		}
+	case ast.Enum, ast.InputObject:
+		ref := &jsonSchema{Ref: "#/$defs/" + def.Name}
+		if _, ok := b.defs[def.Name]; ok {
+			return ref, nil
+		}
+		// Add a placeholder first, in case the type refers to itself.
+		defSchema := &jsonSchema{Description: def.Description}
+		b.defs[def.Name] = defSchema
+
+		if def.Kind == ast.Enum {
+			defSchema.Type = "string"
+			for _, val := range def.EnumValues {
+				defSchema.Enum = append(defSchema.Enum, val.Name)
+			}
+			return ref, nil
+		}
+
+		closed := false
+		defSchema.Type = "object"
+		defSchema.AdditionalProperties = &closed
+		for _, field := range def.Fields {
+			prop, err := b.typeSchema(field.Type, field.Position)
+			if err != nil {
+				return nil, err
+			}
+			prop.Description = field.Description
+			if field.DefaultValue != nil {
+				prop.Default, err = field.DefaultValue.Value(nil)
+				if err != nil {
+					return nil, errorf(field.Position, "invalid default value: %v", err)
+				}
+			} else if field.Type.NonNull {
+				defSchema.Required = append(defSchema.Required, field.Name)
+			}
+			defSchema.Properties = append(defSchema.Properties,
+				&jsonSchemaProperty{Name: field.Name, Schema: prop})
+		}
+		if def.Directives.ForName("oneOf") != nil {
+			one := 1
+			defSchema.MinProperties = &one
+			defSchema.MaxProperties = &one
+		}
+		return ref, nil
+	default:
+		// (Should be impossible in a valid operation.)
+		return nil, errorf(def.Position, "%s is not an input type", def.Name)
+	}
+}
@@ -0,0 +1,115 @@
+package generate
+
+// This file implements `genqlient list`, which lists the operations for which
+// genqlient generates code, for auditing or documentation.
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alexflint/go-arg"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// listedOperation describes a single operation for `genqlient list`.  The
+// JSON tags match those of ExportOperations where they overlap.
+type listedOperation struct {
+	Name           string `json:"operationName"`
+	Kind           string `json:"kind"`
+	SourceFilename string `json:"sourceLocation"`
+}
+
+// listOperations returns the operations in document, sorted by name.
+func listOperations(document *ast.QueryDocument) []*listedOperation {
+	ops := make([]*listedOperation, len(document.Operations))
+	for i, op := range document.Operations {
+		// As in addOperation, we omit the line from pseudo-filenames.
+		sourceFilename := op.Position.Src.Name
+		if i := strings.LastIndex(sourceFilename, ":"); i != -1 {
+			sourceFilename = sourceFilename[:i]
+		}
+		ops[i] = &listedOperation{
+			Name:           op.Name,
+			Kind:           string(op.Operation),
+			SourceFilename: sourceFilename,
+		}
+	}
+	sort.SliceStable(ops, func(i, j int) bool { return ops[i].Name < ops[j].Name })
+	return ops
+}
+
+// writeOperationList writes ops to w, as a table or (if asJSON) a JSON
+// object like that of ExportOperations.
+func writeOperationList(w io.Writer, ops []*listedOperation, asJSON bool) error {
+	if asJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(struct {
+			Operations []*listedOperation `json:"operations"`
+		}{ops})
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, op := range ops {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", op.Name, op.Kind, op.SourceFilename)
+	}
+	return tw.Flush()
+}
+
+type listArgs struct {
+	ConfigFilename string `arg:"positional" placeholder:"CONFIG" default:"" help:"path to genqlient configuration (default: genqlient.yaml in current or any parent directory)"`
+	JSON           bool   `arg:"--json" help:"write the list as JSON"`
+}
+
+func (listArgs) Description() string {
+	return strings.TrimSpace(`
+Lists the operations for which genqlient generates code, with the kind of
+each (query or mutation), and the file in which it's defined.
+`)
+}
+
+// readConfigAndList implements `genqlient list`; it writes the operations
+// to w.
+func readConfigAndList(args *listArgs, w io.Writer) error {
+	config, err := readConfig(args.ConfigFilename)
+	if err != nil {
+		return err
+	}
+	schema, err := getSchema(config.Schema)
+	if err != nil {
+		return err
+	}
+	document, err := getAndValidateQueries(
+		config.baseDir, config.Operations, config.OperationFunctions, schema,
+		config.FragmentsPackage != "")
+	if err != nil {
+		return err
+	}
+	return writeOperationList(w, listOperations(document), args.JSON)
+}
+
+// listMain is the entrypoint for `genqlient list`; args are those following
+// "list".
+func listMain(args []string) error {
+	var parsed listArgs
+	p, err := arg.NewParser(arg.Config{Program: "genqlient list"}, &parsed)
+	if err != nil {
+		return err
+	}
+	err = p.Parse(args)
+	switch err {
+	case nil:
+	case arg.ErrHelp:
+		p.WriteHelp(os.Stdout)
+		return nil
+	default:
+		p.WriteUsage(os.Stdout)
+		return err
+	}
+	return readConfigAndList(&parsed, os.Stdout)
+}
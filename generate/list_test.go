@@ -0,0 +1,26 @@
+package generate
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Khan/genqlient/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList(t *testing.T) {
+	configFilename := filepath.Join("testdata", "list", "genqlient.yaml")
+	for _, asJSON := range []bool{false, true} {
+		name := "Text"
+		if asJSON {
+			name = "JSON"
+		}
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			err := readConfigAndList(&listArgs{ConfigFilename: configFilename, JSON: asJSON}, &out)
+			require.NoError(t, err)
+			testutil.Cupaloy.SnapshotT(t, out.String())
+		})
+	}
+}
@@ -19,19 +19,19 @@ func warn(err error) {
 	fmt.Println(err)
 }
 
-func readConfigGenerateAndWrite(configFilename string) error {
-	var config *Config
-	var err error
+// readConfig reads the config from the given file, or if it's empty, the
+// default locations.
+func readConfig(configFilename string) (*Config, error) {
 	if configFilename != "" {
-		config, err = ReadAndValidateConfig(configFilename)
-		if err != nil {
-			return err
-		}
-	} else {
-		config, err = ReadAndValidateConfigFromDefaultLocations()
-		if err != nil {
-			return err
-		}
+		return ReadAndValidateConfig(configFilename)
+	}
+	return ReadAndValidateConfigFromDefaultLocations()
+}
+
+func readConfigGenerateAndWrite(configFilename string) error {
+	config, err := readConfig(configFilename)
+	if err != nil {
+		return err
 	}
 
 	generated, err := Generate(config)
@@ -39,7 +39,9 @@ func readConfigGenerateAndWrite(configFilename string) error {
 		return err
 	}
 
+	filenames := make([]string, 0, len(generated))
 	for filename, content := range generated {
+		filenames = append(filenames, filename)
 		err = os.MkdirAll(filepath.Dir(filename), 0o755)
 		if err != nil {
 			return errorf(nil,
@@ -53,7 +55,8 @@ func readConfigGenerateAndWrite(configFilename string) error {
 				filename, err)
 		}
 	}
-	return nil
+
+	return runPostGenerateHooks(config, filenames)
 }
 
 type cliArgs struct {
@@ -64,6 +67,8 @@ type cliArgs struct {
 func (cliArgs) Description() string {
 	return strings.TrimSpace(`
 Generates GraphQL client code for a given schema and queries.
+Run "genqlient diff --help" to compare the schema to a live endpoint, or
+"genqlient list --help" to list the operations.
 See https://github.com/Khan/genqlient for full documentation.
 `)
 }
@@ -81,6 +86,17 @@ func Main() {
 		}
 	}
 
+	// go-arg doesn't support subcommands alongside the positional config
+	// argument, so we check for them ourselves.
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		exitIfError(diffMain(os.Args[2:]))
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "list" {
+		exitIfError(listMain(os.Args[2:]))
+		return
+	}
+
 	var args cliArgs
 	arg.MustParse(&args)
 	if args.Init {
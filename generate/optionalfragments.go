@@ -0,0 +1,136 @@
+package generate
+
+// This file implements the optionalFragment option (see
+// docs/genqlient_directive.graphql), which makes a named fragment-spread
+// conditional on a boolean variable.  There are two parts.  First, before we
+// convert the operation, we rewrite the spread to
+//	...MyFragment @include(if: $myVariable)
+// declaring $myVariable if needed.  Then, when we convert the selection-set
+// containing the spread, we embed the fragment's type by pointer, and record
+// which response fields tell us whether the fragment was included, so that
+// UnmarshalJSON can leave the pointer nil if it wasn't.
+
+import (
+	"regexp"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+var graphQLNameRegexp = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// addOptionalFragments rewrites the given operation so that each fragment
+// spread with @genqlient(optionalFragment: "myVariable") is included only if
+// $myVariable is true.
+//
+// Only spreads directly in the operation are rewritten; in a fragment
+// definition we'd have to add the variable to each operation which uses the
+// fragment, so convertFragmentSpread disallows the option there.
+func (g *generator) addOptionalFragments(op *ast.OperationDefinition) error {
+	var rewrite func(selectionSet ast.SelectionSet) error
+	rewrite = func(selectionSet ast.SelectionSet) error {
+		for _, selection := range selectionSet {
+			var err error
+			switch selection := selection.(type) {
+			case *ast.Field:
+				err = rewrite(selection.SelectionSet)
+			case *ast.InlineFragment:
+				err = rewrite(selection.SelectionSet)
+			case *ast.FragmentSpread:
+				err = g.addOptionalFragment(op, selection)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return rewrite(op.SelectionSet)
+}
+
+// addOptionalFragment does the work of addOptionalFragments for a single
+// fragment spread.
+func (g *generator) addOptionalFragment(
+	op *ast.OperationDefinition,
+	fragmentSpread *ast.FragmentSpread,
+) error {
+	_, directive, err := g.parsePrecedingComment(
+		fragmentSpread, nil, fragmentSpread.Position, nil)
+	if err != nil || directive.OptionalFragment == "" {
+		return err
+	}
+	name := directive.OptionalFragment
+
+	if def := op.VariableDefinitions.ForName(name); def != nil {
+		if def.Type.String() != "Boolean!" {
+			return errorf(def.Position,
+				"variable $%s is used by optionalFragment, so must have type "+
+					"Boolean!, not %s", name, def.Type)
+		}
+	} else {
+		op.VariableDefinitions = append(op.VariableDefinitions,
+			&ast.VariableDefinition{
+				Variable:   name,
+				Type:       ast.NonNullNamedType("Boolean", nil),
+				Definition: g.schema.Types["Boolean"],
+			})
+	}
+
+	fragmentSpread.Directives = append(fragmentSpread.Directives, &ast.Directive{
+		Name: "include",
+		Arguments: ast.ArgumentList{{
+			Name:  "if",
+			Value: &ast.Value{Kind: ast.Variable, Raw: name},
+		}},
+		Definition: g.schema.Directives["include"],
+		// Position is nil, which marks this directive as ours; see
+		// isOptionalFragment.
+	})
+	return nil
+}
+
+// isOptionalFragment returns true if the given spread was rewritten by
+// addOptionalFragments.
+func isOptionalFragment(fragmentSpread *ast.FragmentSpread) bool {
+	for _, directive := range fragmentSpread.Directives.ForNames("include") {
+		if directive.Position == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// optionalFragmentKeys returns the response fields (i.e. JSON names) which
+// tell us whether the optional fragment embedded as field was included in a
+// response: those selected by the fragment but by none of the other fields of
+// the containing selection (fields).
+func optionalFragmentKeys(
+	field *goStructField,
+	fields []*goStructField,
+) ([]string, error) {
+	others := make([]*goStructField, 0, len(fields)-1)
+	for _, other := range fields {
+		if other != field {
+			others = append(others, other)
+		}
+	}
+	otherFields, err := (&goStructType{Fields: others}).FlattenedFields()
+	if err != nil {
+		return nil, err
+	}
+	otherNames := make(map[string]bool, len(otherFields))
+	for _, other := range otherFields {
+		otherNames[other.JSONName] = true
+	}
+
+	fragmentFields, err := (&goStructType{Fields: []*goStructField{field}}).FlattenedFields()
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, fragmentField := range fragmentFields {
+		if !otherNames[fragmentField.JSONName] {
+			keys = append(keys, fragmentField.JSONName)
+		}
+	}
+	return keys, nil
+}
@@ -10,9 +10,11 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/bmatcuk/doublestar/v4"
 	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 	"github.com/vektah/gqlparser/v2/parser"
 	"github.com/vektah/gqlparser/v2/validator"
 	_ "github.com/vektah/gqlparser/v2/validator/rules"
@@ -33,6 +35,11 @@ func getSchema(globs StringList) (*ast.Schema, error) {
 		sources[i] = &ast.Source{Name: filename, Input: string(text)}
 	}
 
+	return loadSchema(sources)
+}
+
+// loadSchema parses and validates the schema in the given sources.
+func loadSchema(sources []*ast.Source) (*ast.Schema, error) {
 	// Ideally here we'd just call gqlparser.LoadSchema. But the schema we are
 	// given may or may not contain the builtin types String, Int, etc. (The
 	// spec says it shouldn't, but introspection will return those types, and
@@ -63,6 +70,13 @@ func getSchema(globs StringList) (*ast.Schema, error) {
 		document.Merge(preludeAST)
 	}
 
+	// gqlparser would catch these too, but its error doesn't say where the
+	// other definition is, which is confusing when the schema is split across
+	// several files.
+	if err := checkDuplicateDefinitions(document); err != nil {
+		return nil, err
+	}
+
 	schema, graphqlError := validator.ValidateSchemaDocument(document)
 	if graphqlError != nil {
 		return nil, errorf(nil, "invalid schema: %v", graphqlError)
@@ -71,15 +85,57 @@ func getSchema(globs StringList) (*ast.Schema, error) {
 	return schema, nil
 }
 
-func getAndValidateQueries(basedir string, filenames StringList, schema *ast.Schema) (*ast.QueryDocument, error) {
-	queryDoc, err := getQueries(basedir, filenames)
+// checkDuplicateDefinitions returns an error if the given schema defines any
+// type or directive more than once.  (Extensions of a type are fine.)
+func checkDuplicateDefinitions(document *ast.SchemaDocument) error {
+	types := make(map[string]*ast.Definition, len(document.Definitions))
+	for _, def := range document.Definitions {
+		if other, ok := types[def.Name]; ok {
+			return errorf(def.Position,
+				"invalid schema: type %s is defined more than once (also at %s:%d)",
+				def.Name, other.Position.Src.Name, other.Position.Line)
+		}
+		types[def.Name] = def
+	}
+
+	directives := make(map[string]*ast.DirectiveDefinition, len(document.Directives))
+	for _, dir := range document.Directives {
+		if other, ok := directives[dir.Name]; ok {
+			return errorf(dir.Position,
+				"invalid schema: directive @%s is defined more than once (also at %s:%d)",
+				dir.Name, other.Position.Src.Name, other.Position.Line)
+		}
+		directives[dir.Name] = dir
+	}
+	return nil
+}
+
+// getAndValidateQueries reads the operations from the given files (see
+// getQueries), and validates them against schema.  If sharedFragments is
+// set, the named fragments are those of a fragments_package (see
+// Config.FragmentsPackage), so it's fine if some are unused.
+func getAndValidateQueries(basedir string, filenames, funcs StringList, schema *ast.Schema, sharedFragments bool) (*ast.QueryDocument, error) {
+	queryDoc, err := getQueries(basedir, filenames, funcs)
 	if err != nil {
 		return nil, err
 	}
 
 	// Cf. gqlparser.LoadQuery
 	graphqlErrors := validator.Validate(schema, queryDoc)
-	if graphqlErrors != nil {
+	if sharedFragments || len(queryDoc.Operations) == 0 {
+		// A package of just fragments, to be shared by other packages (see
+		// Config.FragmentsPackage), is expected to have "unused" fragments,
+		// as are the packages using them, which list all the shared
+		// fragment files but may use just some of the fragments.
+		var filtered gqlerror.List
+		for _, graphqlError := range graphqlErrors {
+			if graphqlError.Rule != "NoUnusedFragments" {
+				filtered = append(filtered, graphqlError)
+			}
+		}
+		graphqlErrors = filtered
+	}
+	if len(graphqlErrors) > 0 {
 		return nil, errorf(nil, "query-spec does not match schema: %v", graphqlErrors)
 	}
 
@@ -103,14 +159,15 @@ func expandFilenames(globs []string) ([]string, error) {
 			uniqFilenames[path.Join(base, match)] = true
 		}
 	}
-	filenames := make([]string, 0, len(uniqFilenames))
-	for filename := range uniqFilenames {
-		filenames = append(filenames, filename)
-	}
-	return filenames, nil
+	// Sort, so that operations and fragments (and thus the generated code, and
+	// any errors) don't depend on map order.
+	return sortedKeys(uniqFilenames), nil
 }
 
-func getQueries(basedir string, globs StringList) (*ast.QueryDocument, error) {
+// getQueries reads the operations from the given files.  In Go files, string
+// literals starting with "# @genqlient", as well as string literals passed to
+// any of funcs, are treated as operations.
+func getQueries(basedir string, globs, funcs StringList) (*ast.QueryDocument, error) {
 	// We merge all the queries into a single query-document, since operations
 	// in one might reference fragments in another.
 	//
@@ -141,10 +198,20 @@ func getQueries(basedir string, globs StringList) (*ast.QueryDocument, error) {
 				return nil, err
 			}
 
+			err = nameAnonymousOperation([]*ast.QueryDocument{queryDoc}, filename)
+			if err != nil {
+				return nil, err
+			}
+
 			addQueryDoc(queryDoc)
 
 		case ".go":
-			queryDocs, err := getQueriesFromGo(string(text), basedir, filename)
+			queryDocs, err := getQueriesFromGo(string(text), basedir, filename, funcs)
+			if err != nil {
+				return nil, err
+			}
+
+			err = nameAnonymousOperation(queryDocs, filename)
 			if err != nil {
 				return nil, err
 			}
@@ -178,13 +245,103 @@ func getQueriesFromString(text string, basedir, filename string) (*ast.QueryDocu
 	return document, nil
 }
 
-func getQueriesFromGo(text string, basedir, filename string) ([]*ast.QueryDocument, error) {
+// nameAnonymousOperation gives the anonymous (unnamed) operation among docs,
+// which all come from filename, a name derived from filename; see
+// operationNameForFile.  genqlient needs a name for each operation, to name
+// the generated function and types; this lets a file contain just a single
+// anonymous operation, which is a common style.
+//
+// Since the name comes from the file, there may be at most one anonymous
+// operation per file.  (And, as GraphQL requires, it must be the only
+// operation in its document.)
+func nameAnonymousOperation(docs []*ast.QueryDocument, filename string) error {
+	var anonymous *ast.OperationDefinition
+	for _, doc := range docs {
+		for _, op := range doc.Operations {
+			if op.Name != "" {
+				continue
+			}
+			if len(doc.Operations) > 1 {
+				return errorf(op.Position,
+					"anonymous operations must be the only operation in their document")
+			}
+			if anonymous != nil {
+				return errorf(op.Position,
+					"only one anonymous operation is allowed per file, since its "+
+						"name is derived from the filename; add an operation-name")
+			}
+			anonymous = op
+		}
+	}
+
+	if anonymous != nil {
+		anonymous.Name = operationNameForFile(filename)
+	}
+	return nil
+}
+
+// operationNameForFile returns the name to use for an anonymous operation in
+// the given file: its base name, without extension, converted to camel-case.
+// For example, the operation in get-user.graphql is named getUser, and that in
+// GetUser.graphql or GetUser.go is named GetUser.
+func operationNameForFile(filename string) string {
+	base := filepath.Base(filename)
+	base = strings.TrimSuffix(base, filepath.Ext(base))
+	words := strings.FieldsFunc(base, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	for i := 1; i < len(words); i++ {
+		words[i] = upperFirst(words[i])
+	}
+	return makeIdentifier(strings.Join(words, ""))
+}
+
+// callName returns the name of the function called by call, as it's written
+// in the source: "f" or "pkg.F" (or "x.Method").  It returns "" if the
+// function is something more complicated, like a function literal.
+func callName(call *goAst.CallExpr) string {
+	switch fun := call.Fun.(type) {
+	case *goAst.Ident:
+		return fun.Name
+	case *goAst.SelectorExpr:
+		if x, ok := fun.X.(*goAst.Ident); ok {
+			return x.Name + "." + fun.Sel.Name
+		}
+	}
+	return ""
+}
+
+func getQueriesFromGo(text string, basedir, filename string, funcs StringList) ([]*ast.QueryDocument, error) {
 	fset := goToken.NewFileSet()
 	f, err := goParser.ParseFile(fset, filename, text, 0)
 	if err != nil {
 		return nil, errorf(nil, "invalid Go file %v: %v", filename, err)
 	}
 
+	// String literals passed directly to one of funcs are operations, even
+	// without the "# @genqlient" marker.
+	funcArgs := map[*goAst.BasicLit]bool{}
+	if len(funcs) > 0 {
+		goAst.Inspect(f, func(node goAst.Node) bool {
+			call, ok := node.(*goAst.CallExpr)
+			if !ok {
+				return true
+			}
+			name := callName(call)
+			for _, fn := range funcs {
+				if name != fn {
+					continue
+				}
+				for _, arg := range call.Args {
+					if basicLit, ok := arg.(*goAst.BasicLit); ok {
+						funcArgs[basicLit] = true
+					}
+				}
+			}
+			return true
+		})
+	}
+
 	var retval []*ast.QueryDocument
 	goAst.Inspect(f, func(node goAst.Node) bool {
 		if err != nil {
@@ -202,7 +359,8 @@ func getQueriesFromGo(text string, basedir, filename string) ([]*ast.QueryDocume
 			return false
 		}
 
-		if !strings.HasPrefix(strings.TrimSpace(value), "# @genqlient") {
+		if !funcArgs[basicLit] &&
+			!strings.HasPrefix(strings.TrimSpace(value), "# @genqlient") {
 			return true
 		}
 
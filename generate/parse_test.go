@@ -7,13 +7,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/vektah/gqlparser/v2/ast"
 )
 
 var (
 	parseDataDir       = "testdata/parsing"
 	parseErrorsDir     = "testdata/parsing-errors"
+	parseFunctionsDir  = "testdata/parsing-functions"
 	expandFilenamesDir = "testdata/expandFilenames"
+	schemasDir         = "testdata/schemas"
 )
 
 func sortQueries(queryDoc *ast.QueryDocument) {
@@ -27,7 +30,7 @@ func sortQueries(queryDoc *ast.QueryDocument) {
 
 func getTestQueries(t *testing.T, ext string) *ast.QueryDocument {
 	graphqlQueries, err := getQueries(
-		parseDataDir, []string{filepath.Join(parseDataDir, "*."+ext)})
+		parseDataDir, []string{filepath.Join(parseDataDir, "*."+ext)}, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -70,6 +73,25 @@ func TestParse(t *testing.T) {
 	}
 }
 
+// TestParseOperationFunctions tests that string literals passed to the
+// configured operation_functions are extracted from Go files.
+func TestParseOperationFunctions(t *testing.T) {
+	queries, err := getQueries(
+		parseFunctionsDir,
+		[]string{filepath.Join(parseFunctionsDir, "*.go")},
+		[]string{"genqlient.Query", "gql"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sortQueries(queries)
+	var names []string
+	for _, op := range queries.Operations {
+		names = append(names, op.Name)
+	}
+	assert.Equal(t, []string{"GetUser", "GetViewer", "WithMarker"}, names)
+}
+
 func removeComments(gotWithComments string) string {
 	var gots []string
 	for _, s := range strings.Split(gotWithComments, "\n") {
@@ -113,8 +135,9 @@ func TestExpandFilenames(t *testing.T) {
 				t.Errorf("got %v, wanted error", files)
 			} else if !test.err && err != nil {
 				t.Errorf("got error %v, wanted %v", err, test.files)
-			} else {
-				assert.ElementsMatch(t, filepathJoinAll(expandFilenamesDir, test.files), files)
+			} else if !test.err {
+				// (in sorted order)
+				assert.Equal(t, filepathJoinAll(expandFilenamesDir, test.files), files)
 			}
 		})
 	}
@@ -129,7 +152,7 @@ func TestParseErrors(t *testing.T) {
 		t.Run(ext, func(t *testing.T) {
 			g, err := getQueries(
 				parseErrorsDir,
-				[]string{filepath.Join(parseErrorsDir, "*."+ext)})
+				[]string{filepath.Join(parseErrorsDir, "*."+ext)}, nil)
 			if err == nil {
 				t.Errorf("expected error from getQueries(*.%v)", ext)
 				t.Logf("%#v", g)
@@ -137,3 +160,29 @@ func TestParseErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestOperationNameForFile(t *testing.T) {
+	tests := []test{
+		{"GraphQL", "GetUser.graphql", "GetUser"},
+		{"Go", "queries/GetUser.go", "GetUser"},
+		{"Dashes", "path/to/get-user.graphql", "getUser"},
+		{"Dots", "get.user.v2.graphql", "getUserV2"},
+		{"Underscores", "get_user.gql", "get_user"},
+		{"LeadingDigit", "2fa.graphql", "fa"},
+	}
+
+	testStringFunc(t, operationNameForFile, tests)
+}
+
+func TestGetSchemaMultipleFiles(t *testing.T) {
+	schema, err := getSchema([]string{filepath.Join(schemasDir, "split", "*.graphql")})
+	require.NoError(t, err)
+	assert.NotNil(t, schema.Query)
+	assert.Len(t, schema.Types["User"].Fields, 2)
+	assert.NotNil(t, schema.Directives["cached"])
+
+	_, err = getSchema([]string{filepath.Join(schemasDir, "duplicate", "*.graphql")})
+	assert.EqualError(t, err, filepath.Join(schemasDir, "duplicate", "b.graphql")+
+		":1: invalid schema: type User is defined more than once (also at "+
+		filepath.Join(schemasDir, "duplicate", "a.graphql")+":5)")
+}
@@ -0,0 +1,67 @@
+package generate
+
+// This file implements plugins, which are code generation steps run on the
+// parsed schema and operations; genqlient's own generation is the first (see
+// builtinPlugin in generate.go), and users may add more; see Plugin.
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// A Plugin is a code generation step, which [Generate] runs on the parsed
+// schema and operations.  genqlient's own generation of Go code (and of the
+// other artifacts it writes) is itself the first plugin [Generate] runs;
+// custom plugins, registered in [Config.Plugins], run after it, in order, for
+// example to write additional artifacts (such as TypeScript types, mocks, or
+// documentation) from the same operations.
+type Plugin interface {
+	// Generate does the plugin's work.  It may read ctx's configuration,
+	// schema, and operations, and add to (or modify) ctx.Files.  If it
+	// returns an error, [Generate] fails with that error.
+	Generate(ctx *GenContext) error
+}
+
+// A PluginFunc is a function which implements [Plugin].
+type PluginFunc func(ctx *GenContext) error
+
+// Generate calls f(ctx).
+func (f PluginFunc) Generate(ctx *GenContext) error { return f(ctx) }
+
+// GenContext is the input and output of a [Plugin].
+type GenContext struct {
+	// The configuration, which plugins should not modify.
+	Config *Config
+	// The schema, as parsed by gqlparser.
+	Schema *ast.Schema
+	// The operations and fragments, validated against the schema.  (In
+	// types_only mode, there are none.)
+	Document *ast.QueryDocument
+	// The files generated so far, from filename to content, as returned by
+	// [Generate]: the generated Go code, any other artifacts genqlient
+	// writes (such as export_operations), and any files written by earlier
+	// plugins.  Filenames are absolute; to write a file relative to the
+	// config file, use [GenContext.Path].
+	Files map[string][]byte
+}
+
+// Path returns the absolute path of the given path, which is relative to
+// the directory of the config file (as are the paths in genqlient.yaml).
+func (ctx *GenContext) Path(path string) string {
+	return pathJoin(ctx.Config.baseDir, path)
+}
+
+// runPlugins runs each of plugins on ctx, in order.
+func runPlugins(plugins []Plugin, ctx *GenContext) error {
+	for _, plugin := range plugins {
+		err := plugin.Generate(ctx)
+		if err == nil {
+			continue
+		}
+		if _, ok := plugin.(builtinPlugin); ok {
+			// genqlient's own errors need no introduction.
+			return err
+		}
+		return errorf(nil, "plugin %T failed: %v", plugin, err)
+	}
+	return nil
+}
@@ -0,0 +1,641 @@
+package generate
+
+// This file generates the Protocol Buffers definitions enabled by the
+// emit_proto option: a .proto file with a message mirroring each response
+// type (and an enum mirroring each enum type), for bridging responses onto a
+// protobuf-based bus.  For a response type
+//	type GetUserUser struct {
+//		Id     string   `json:"id"`
+//		Emails []string `json:"emails"`
+//	}
+// we generate
+//	message GetUserUser {
+//	  string id = 1;
+//	  repeated string emails = 2;
+//	}
+// and, if proto_go_package is set, a Go file for that package (alongside
+// the code protoc generates) with converters like
+//	func GetUserUserToProto(v *queries.GetUserUser) *GetUserUser
+//
+// Interfaces and unions become messages with a oneof of their
+// implementations; nullable scalars and enums become optional fields.
+//
+// Fields (and enum values, and oneof members) are numbered in order the
+// first time, but after that we keep the numbers in the existing .proto
+// file, so that the wire format stays compatible as queries change: new
+// fields get new numbers, and the numbers of removed fields are reserved.
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// protoScalarGoTypes maps each protobuf scalar type to the Go type
+// protoc-gen-go uses for it.
+var protoScalarGoTypes = map[string]string{
+	"double": "float64", "float": "float32",
+	"int32": "int32", "int64": "int64", "uint32": "uint32", "uint64": "uint64",
+	"sint32": "int32", "sint64": "int64", "fixed32": "uint32", "fixed64": "uint64",
+	"sfixed32": "int32", "sfixed64": "int64",
+	"bool": "bool", "string": "string", "bytes": "[]byte",
+}
+
+// defaultProtoScalars are the protobuf types for the builtin GraphQL
+// scalars, unless overridden in proto_scalars.
+var defaultProtoScalars = map[string]string{
+	"String":  "string",
+	"ID":      "string",
+	"Int":     "int64",
+	"Float":   "double",
+	"Boolean": "bool",
+}
+
+// protoGenerator collects the messages and enums for emit_proto.
+type protoGenerator struct {
+	g *generator
+	// The types for which we write messages and enums, by name.
+	types map[string]goType
+	// The alias of the generated package, in the converters.
+	pkg string
+	// The numbers used in the existing .proto file, by message or enum name.
+	prevNumbers map[string]*protoNumbers
+}
+
+// protoNumbers are the numbers used by a message or enum in a .proto file.
+type protoNumbers struct {
+	// The number of each field, oneof member, or enum value, by name.
+	numbers map[string]int
+	// The numbers reserved for fields which were removed.
+	reserved []int
+}
+
+var (
+	protoDefinitionRegexp = regexp.MustCompile(`^(?:message|enum) (\w+) \{$`)
+	protoFieldRegexp      = regexp.MustCompile(`^\s+(?:[\w.]+ )*(\w+) = (\d+);$`)
+	protoReservedRegexp   = regexp.MustCompile(`^\s+reserved ([\d, ]+);$`)
+)
+
+// readProtoNumbers reads the numbers used by each message and enum in the
+// .proto file previously written by emit_proto, if any.  (It understands
+// just the format we write.)
+func readProtoNumbers(filename string) (map[string]*protoNumbers, error) {
+	content, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errorf(nil, "unable to read existing emit_proto file: %v", err)
+	}
+
+	retval := map[string]*protoNumbers{}
+	var current *protoNumbers
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if match := protoDefinitionRegexp.FindStringSubmatch(line); match != nil {
+			current = &protoNumbers{numbers: map[string]int{}}
+			retval[match[1]] = current
+		} else if current == nil {
+			continue
+		} else if match := protoReservedRegexp.FindStringSubmatch(line); match != nil {
+			for _, number := range strings.Split(match[1], ",") {
+				n, err := strconv.Atoi(strings.TrimSpace(number))
+				if err != nil {
+					return nil, errorf(nil, "invalid reserved number in %s: %v", filename, err)
+				}
+				current.reserved = append(current.reserved, n)
+			}
+		} else if match := protoFieldRegexp.FindStringSubmatch(line); match != nil {
+			n, _ := strconv.Atoi(match[2])
+			if n > 0 { // (not an enum's _UNSPECIFIED value)
+				current.numbers[match[1]] = n
+			}
+		}
+	}
+	return retval, scanner.Err()
+}
+
+// assignNumbers returns the numbers for the fields (or enum values, or oneof
+// members) of the given message or enum, which are numbered in order from 1
+// unless it was in the existing .proto file.  If so, those which were there
+// keep their numbers, and new ones get numbers after all those used before.
+// It also returns the numbers to reserve: those of the removed fields, and
+// those reserved before.
+func (p *protoGenerator) assignNumbers(name string, fieldNames []string) (numbers, reserved []int) {
+	numbers = make([]int, len(fieldNames))
+	prev := p.prevNumbers[name]
+	if prev == nil {
+		for i := range fieldNames {
+			numbers[i] = i + 1
+		}
+		return numbers, nil
+	}
+
+	next := 1
+	for _, n := range prev.numbers {
+		if n >= next {
+			next = n + 1
+		}
+	}
+	for _, n := range prev.reserved {
+		if n >= next {
+			next = n + 1
+		}
+	}
+	used := map[string]bool{}
+	for i, fieldName := range fieldNames {
+		used[fieldName] = true
+		if n, ok := prev.numbers[fieldName]; ok {
+			numbers[i] = n
+		} else {
+			numbers[i] = next
+			next++
+		}
+	}
+	reserved = append(reserved, prev.reserved...)
+	for fieldName, n := range prev.numbers {
+		if !used[fieldName] {
+			reserved = append(reserved, n)
+		}
+	}
+	sort.Ints(reserved)
+	return numbers, reserved
+}
+
+// writeProtoReserved writes the reserved statement for the given numbers,
+// if any.
+func writeProtoReserved(w *strings.Builder, reserved []int) {
+	if len(reserved) == 0 {
+		return
+	}
+	strs := make([]string, len(reserved))
+	for i, n := range reserved {
+		strs[i] = strconv.Itoa(n)
+	}
+	fmt.Fprintf(w, "  reserved %s;\n", strings.Join(strs, ", "))
+}
+
+// protoFiles returns the files for emit_proto: the .proto file and, if
+// proto_go_package is set, the converters, by filename.
+func (g *generator) protoFiles() (map[string][]byte, error) {
+	p := &protoGenerator{g: g, types: map[string]goType{}, pkg: g.Config.Package}
+	for _, op := range g.Operations {
+		if err := p.collect(g.typeMap[op.ResponseName]); err != nil {
+			return nil, err
+		}
+	}
+
+	filename := filepath.Join(g.Config.EmitProto, g.Config.Package+".proto")
+	var err error
+	p.prevNumbers, err = readProtoNumbers(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var protoBuf strings.Builder
+	fmt.Fprintf(&protoBuf, "// Code generated by github.com/Khan/genqlient, DO NOT EDIT.\n\n")
+	fmt.Fprintf(&protoBuf, "syntax = \"proto3\";\n\npackage %s;\n", g.Config.Package)
+	if g.Config.ProtoGoPackage != "" {
+		fmt.Fprintf(&protoBuf, "\noption go_package = %s;\n", strconv.Quote(g.Config.ProtoGoPackage))
+	}
+	for _, name := range sortedKeys(p.types) {
+		protoBuf.WriteString("\n")
+		if err := p.writeDefinition(&protoBuf, p.types[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	retval := map[string][]byte{filename: []byte(protoBuf.String())}
+	if g.Config.ProtoGoPackage == "" {
+		return retval, nil
+	}
+
+	if g.Config.pkgPath == "" {
+		return nil, errorf(nil, "proto_go_package requires the import path of the generated "+
+			"package, but genqlient couldn't find it")
+	}
+	var goBuf strings.Builder
+	fmt.Fprintf(&goBuf, "// Code generated by github.com/Khan/genqlient, DO NOT EDIT.\n\n")
+	fmt.Fprintf(&goBuf, "package %s\n\nimport %s %s\n",
+		path.Base(g.Config.ProtoGoPackage), p.pkg, strconv.Quote(g.Config.pkgPath))
+	for _, name := range sortedKeys(p.types) {
+		goBuf.WriteString("\n")
+		if err := p.writeConverter(&goBuf, p.types[name]); err != nil {
+			return nil, err
+		}
+	}
+	formatted, err := format.Source([]byte(goBuf.String()))
+	if err != nil {
+		return nil, goSourceError("gofmt", []byte(goBuf.String()), err)
+	}
+	retval[filepath.Join(g.Config.EmitProto, g.Config.Package+"_proto.go")] = formatted
+	return retval, nil
+}
+
+// collect adds typ, and the types it refers to, to p.types.
+func (p *protoGenerator) collect(typ goType) error {
+	switch typ := typ.(type) {
+	case *goPointerType:
+		return p.collect(typ.Elem)
+	case *goSliceType:
+		if _, ok := unwrapPointer(typ.Elem).(*goSliceType); ok {
+			return errorf(nil, "emit_proto does not support lists of lists (in %s)",
+				typ.Reference())
+		}
+		return p.collect(typ.Elem)
+	case *goGenericType:
+		return errorf(nil, "emit_proto does not support generic types (%s)", typ.Reference())
+	case *goEnumType, *goStructType, *goInterfaceType:
+		name := typ.Reference()
+		if strings.Contains(name, ".") {
+			return errorf(nil, "emit_proto does not support fragments_package (%s)", name)
+		}
+		if _, ok := p.types[name]; ok {
+			return nil
+		}
+		if p.g.Config.ProtoGoPackage != "" && !token.IsExported(name) {
+			return errorf(nil, "proto_go_package requires exported types, but %s is unexported", name)
+		}
+		p.types[name] = typ
+
+		switch typ := typ.(type) {
+		case *goStructType:
+			fields, err := typ.FlattenedFields()
+			if err != nil {
+				return err
+			}
+			for _, field := range fields {
+				if err := p.collect(field.GoType); err != nil {
+					return err
+				}
+			}
+		case *goInterfaceType:
+			for _, impl := range typ.Implementations {
+				if err := p.collect(impl); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	case *goOpaqueType, *goTypenameForBuiltinType, *goStringerType:
+		_, err := p.scalarType(typ.GraphQLTypeName())
+		return err
+	default:
+		return errorf(nil, "genqlient internal error: unexpected type %T", typ)
+	}
+}
+
+func unwrapPointer(typ goType) goType {
+	if ptr, ok := typ.(*goPointerType); ok {
+		return ptr.Elem
+	}
+	return typ
+}
+
+// scalarType returns the protobuf type for the given GraphQL scalar.
+func (p *protoGenerator) scalarType(graphQLName string) (string, error) {
+	if protoType, ok := p.g.Config.ProtoScalars[graphQLName]; ok {
+		return protoType, nil
+	}
+	if protoType, ok := defaultProtoScalars[graphQLName]; ok {
+		return protoType, nil
+	}
+	return "", errorf(nil, "emit_proto needs a protobuf type for scalar %s; "+
+		"set one in proto_scalars", graphQLName)
+}
+
+// fieldType returns the protobuf type of a field of the given Go type, and
+// its label ("repeated", "optional", or "").
+func (p *protoGenerator) fieldType(typ goType) (protoType, label string, err error) {
+	switch typ := typ.(type) {
+	case *goPointerType:
+		protoType, label, err = p.fieldType(typ.Elem)
+		if label == "" {
+			switch typ.Elem.(type) {
+			case *goStructType, *goInterfaceType:
+				// Messages are always nullable.
+			default:
+				label = "optional"
+			}
+		}
+		return protoType, label, err
+	case *goSliceType:
+		protoType, _, err = p.fieldType(typ.Elem)
+		return protoType, "repeated", err
+	case *goEnumType, *goStructType, *goInterfaceType:
+		return typ.Reference(), "", nil
+	default:
+		protoType, err = p.scalarType(typ.GraphQLTypeName())
+		return protoType, "", err
+	}
+}
+
+// protoFieldName returns the name of the protobuf field for the given JSON
+// name: the same, but without leading underscores (as in __typename).
+func protoFieldName(jsonName string) string {
+	return strings.TrimLeft(jsonName, "_")
+}
+
+// protoGoName returns the Go name protoc-gen-go uses for a protobuf field
+// (or oneof) of the given name: for example, user_id and userId both become
+// UserId.  (This follows protoc-gen-go's GoCamelCase, for names without
+// dots.)
+func protoGoName(name string) string {
+	isLower := func(c byte) bool { return 'a' <= c && c <= 'z' }
+	isDigit := func(c byte) bool { return '0' <= c && c <= '9' }
+	var b []byte
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '_' && i == 0:
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(name) && isLower(name[i+1]):
+			// Skip the underscore, and capitalize the next letter.
+		case isDigit(c):
+			b = append(b, c)
+		default:
+			// Capitalize the first letter of each word, which continues
+			// with any lowercase letters.
+			if isLower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(name) && isLower(name[i+1]); i++ {
+				b = append(b, name[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+// protoEnumPrefix returns the prefix for the values of a protobuf enum of
+// the given name (which must be unique within the package, since protobuf
+// enum values are scoped like C++), e.g. USER_ROLE for UserRole.
+func protoEnumPrefix(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) && !unicode.IsUpper(rune(name[i-1])) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
+func writeProtoComment(w *strings.Builder, indent, desc string) {
+	if desc == "" {
+		return
+	}
+	for _, line := range strings.Split(desc, "\n") {
+		line = strings.TrimLeft(line, " \t")
+		if line == "" {
+			fmt.Fprintf(w, "%s//\n", indent)
+		} else {
+			fmt.Fprintf(w, "%s// %s\n", indent, line)
+		}
+	}
+}
+
+// writeDefinition writes the protobuf message or enum for typ.
+func (p *protoGenerator) writeDefinition(w *strings.Builder, typ goType) error {
+	switch typ := typ.(type) {
+	case *goEnumType:
+		prefix := protoEnumPrefix(typ.GoName)
+		writeProtoComment(w, "", typ.Description)
+		fmt.Fprintf(w, "enum %s {\n  %s_UNSPECIFIED = 0;\n", typ.GoName, prefix)
+		names := make([]string, len(typ.Values))
+		for i, val := range typ.Values {
+			names[i] = prefix + "_" + strings.ToUpper(val.GraphQLName)
+		}
+		numbers, reserved := p.assignNumbers(typ.GoName, names)
+		for i, val := range typ.Values {
+			writeProtoComment(w, "  ", val.Description)
+			fmt.Fprintf(w, "  %s = %d;\n", names[i], numbers[i])
+		}
+		writeProtoReserved(w, reserved)
+		fmt.Fprintf(w, "}\n")
+
+	case *goStructType:
+		writeProtoComment(w, "", structDescription(typ))
+		fmt.Fprintf(w, "message %s {\n", typ.GoName)
+		fields, err := typ.FlattenedFields()
+		if err != nil {
+			return err
+		}
+		seen := map[string]bool{}
+		names := make([]string, len(fields))
+		for i, field := range fields {
+			names[i] = protoFieldName(field.JSONName)
+			if seen[names[i]] {
+				return errorf(nil, "emit_proto: fields of %s have the same protobuf name %s",
+					typ.GoName, names[i])
+			}
+			seen[names[i]] = true
+		}
+		numbers, reserved := p.assignNumbers(typ.GoName, names)
+		for i, field := range fields {
+			protoType, label, err := p.fieldType(field.GoType)
+			if err != nil {
+				return err
+			}
+			if label != "" {
+				label += " "
+			}
+			writeProtoComment(w, "  ", field.Description)
+			fmt.Fprintf(w, "  %s%s %s = %d;\n", label, protoType, names[i], numbers[i])
+		}
+		writeProtoReserved(w, reserved)
+		fmt.Fprintf(w, "}\n")
+
+	case *goInterfaceType:
+		writeProtoComment(w, "", interfaceDescription(typ))
+		fmt.Fprintf(w, "message %s {\n  oneof value {\n", typ.GoName)
+		names := make([]string, len(typ.Implementations))
+		for i, impl := range typ.Implementations {
+			names[i] = lowerFirst(impl.GraphQLName)
+		}
+		numbers, reserved := p.assignNumbers(typ.GoName, names)
+		for i, impl := range typ.Implementations {
+			fmt.Fprintf(w, "    %s %s = %d;\n", impl.GoName, names[i], numbers[i])
+		}
+		fmt.Fprintf(w, "  }\n")
+		writeProtoReserved(w, reserved)
+		fmt.Fprintf(w, "}\n")
+	}
+	return nil
+}
+
+// writeConverter writes the function which converts typ to its protobuf
+// message or enum.
+func (p *protoGenerator) writeConverter(w *strings.Builder, typ goType) error {
+	name := typ.Reference()
+	funcName := name + "ToProto"
+	switch typ := typ.(type) {
+	case *goEnumType:
+		prefix := protoEnumPrefix(typ.GoName)
+		writeDescription(w, fmt.Sprintf(
+			"%s converts a %s.%s to a %s; unknown values become %s_%s_UNSPECIFIED.",
+			funcName, p.pkg, name, name, name, prefix))
+		fmt.Fprintf(w, "func %s(v %s.%s) %s {\nswitch v {\n", funcName, p.pkg, name, name)
+		for _, val := range typ.Values {
+			fmt.Fprintf(w, "case %s.%s:\nreturn %s_%s_%s\n",
+				p.pkg, val.GoName, name, prefix, strings.ToUpper(val.GraphQLName))
+		}
+		fmt.Fprintf(w, "}\nreturn %s_%s_UNSPECIFIED\n}\n", name, prefix)
+
+	case *goStructType:
+		writeDescription(w, fmt.Sprintf("%s converts a %s.%s to a %s.", funcName, p.pkg, name, name))
+		fmt.Fprintf(w, "func %s(v *%s.%s) *%s {\n", funcName, p.pkg, name, name)
+		fmt.Fprintf(w, "if v == nil {\nreturn nil\n}\nretval := &%s{}\n", name)
+		fields, err := typ.FlattenedFields()
+		if err != nil {
+			return err
+		}
+		for _, field := range fields {
+			var conversion strings.Builder
+			err := p.writeConversion(&conversion,
+				"retval."+protoGoName(protoFieldName(field.JSONName)),
+				"v."+field.Selector, field.GoType, 0)
+			if err != nil {
+				return err
+			}
+			if field.OptionalEmbed != "" {
+				fmt.Fprintf(w, "if v.%s != nil {\n%s}\n", field.OptionalEmbed, conversion.String())
+			} else {
+				w.WriteString(conversion.String())
+			}
+		}
+		fmt.Fprintf(w, "return retval\n}\n")
+
+	case *goInterfaceType:
+		writeDescription(w, fmt.Sprintf("%s converts a %s.%s to a %s.", funcName, p.pkg, name, name))
+		fmt.Fprintf(w, "func %s(v %s.%s) *%s {\nswitch v := v.(type) {\n", funcName, p.pkg, name, name)
+		for _, impl := range typ.Implementations {
+			goName := protoGoName(lowerFirst(impl.GraphQLName))
+			fmt.Fprintf(w, "case *%s.%s:\nreturn &%s{Value: &%s_%s{%s: %sToProto(v)}}\n",
+				p.pkg, impl.GoName, name, name, goName, goName, impl.GoName)
+		}
+		fmt.Fprintf(w, "}\nreturn nil\n}\n")
+	}
+	return nil
+}
+
+// goType returns the Go type protoc-gen-go generates for a field of the
+// given (genqlient) Go type, ignoring optional-ness.
+func (p *protoGenerator) goType(typ goType) (string, error) {
+	switch typ := typ.(type) {
+	case *goPointerType:
+		return p.goType(typ.Elem)
+	case *goSliceType:
+		elem, err := p.goType(typ.Elem)
+		return "[]" + elem, err
+	case *goEnumType:
+		return typ.GoName, nil
+	case *goStructType:
+		return "*" + typ.GoName, nil
+	case *goInterfaceType:
+		return "*" + typ.GoName, nil
+	default:
+		protoType, err := p.scalarType(typ.GraphQLTypeName())
+		if err != nil {
+			return "", err
+		}
+		goType, ok := protoScalarGoTypes[protoType]
+		if !ok {
+			return "", errorf(nil, "genqlient internal error: unknown protobuf type %s", protoType)
+		}
+		return goType, nil
+	}
+}
+
+// writeConversion writes the code to set the Go expression dst (of the type
+// protoc-gen-go generates) to the converted value of the addressable Go
+// expression src, of the given type.  depth is used to name loop variables.
+func (p *protoGenerator) writeConversion(w *strings.Builder, dst, src string, typ goType, depth int) error {
+	switch typ := typ.(type) {
+	case *goPointerType:
+		switch typ.Elem.(type) {
+		case *goStructType:
+			// (The converter handles nil.)
+			fmt.Fprintf(w, "%s = %sToProto(%s)\n", dst, typ.Elem.Reference(), src)
+			return nil
+		case *goInterfaceType:
+			fmt.Fprintf(w, "if %s != nil {\n%s = %sToProto(*%s)\n}\n",
+				src, dst, typ.Elem.Reference(), src)
+			return nil
+		case *goSliceType:
+			var inner strings.Builder
+			err := p.writeConversion(&inner, dst, "(*"+src+")", typ.Elem, depth)
+			fmt.Fprintf(w, "if %s != nil {\n%s}\n", src, inner.String())
+			return err
+		}
+		// An optional scalar or enum, which is a pointer in protobuf too.
+		goType, err := p.goType(typ.Elem)
+		if err != nil {
+			return err
+		}
+		tmp := fmt.Sprintf("v%d", depth)
+		var inner strings.Builder
+		err = p.writeConversion(&inner, tmp, "(*"+src+")", typ.Elem, depth+1)
+		fmt.Fprintf(w, "if %s != nil {\nvar %s %s\n%s%s = &%s\n}\n",
+			src, tmp, goType, inner.String(), dst, tmp)
+		return err
+
+	case *goSliceType:
+		goType, err := p.goType(typ)
+		if err != nil {
+			return err
+		}
+		i := fmt.Sprintf("i%d", depth)
+		elemDst, elemSrc := fmt.Sprintf("%s[%s]", dst, i), fmt.Sprintf("%s[%s]", src, i)
+		var inner strings.Builder
+		if ptr, ok := typ.Elem.(*goPointerType); ok {
+			// Repeated fields can't have null elements, so we convert those
+			// to the zero value (for messages, an empty one).
+			if _, ok := ptr.Elem.(*goStructType); ok {
+				fmt.Fprintf(&inner, "%s = &%s{}\nif %s != nil {\n%s = %sToProto(%s)\n}\n",
+					elemDst, ptr.Elem.Reference(), elemSrc, elemDst, ptr.Elem.Reference(), elemSrc)
+			} else {
+				var deref strings.Builder
+				err = p.writeConversion(&deref, elemDst, "(*"+elemSrc+")", ptr.Elem, depth+1)
+				fmt.Fprintf(&inner, "if %s != nil {\n%s}\n", elemSrc, deref.String())
+			}
+		} else {
+			err = p.writeConversion(&inner, elemDst, elemSrc, typ.Elem, depth+1)
+		}
+		fmt.Fprintf(w, "%s = make(%s, len(%s))\nfor %s := range %s {\n%s}\n",
+			dst, goType, src, i, src, inner.String())
+		return err
+
+	case *goEnumType:
+		fmt.Fprintf(w, "%s = %sToProto(%s)\n", dst, typ.GoName, src)
+	case *goStructType:
+		fmt.Fprintf(w, "%s = %sToProto(&%s)\n", dst, typ.GoName, src)
+	case *goInterfaceType:
+		fmt.Fprintf(w, "%s = %sToProto(%s)\n", dst, typ.GoName, src)
+	case *goStringerType:
+		goType, err := p.goType(typ)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s = %s(%s.Value)\n", dst, goType, src)
+	default:
+		// A scalar, which must be convertible to the protobuf Go type.
+		goType, err := p.goType(typ)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s = %s(%s)\n", dst, goType, src)
+	}
+	return nil
+}
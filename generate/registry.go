@@ -0,0 +1,59 @@
+package generate
+
+// This file generates the operations registry enabled by the
+// generate_registry option: maps from each operation's name, and from the
+// hash of its document, to the document.  A server can import the generated
+// package and use them to validate incoming (e.g. persisted) queries against
+// exactly what the client sends.
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var registryNames = []string{"OperationsByName", "OperationsByHash"}
+
+// operationHash returns the hash of op's document, as it's sent to the
+// server: the hex-encoded SHA-256, as used by Apollo's automatic persisted
+// queries.
+func operationHash(op *operation) string {
+	hash := sha256.Sum256([]byte(op.Body))
+	return hex.EncodeToString(hash[:])
+}
+
+// writeRegistry writes the operations registry for g.Operations, which must
+// already be sorted.
+func (g *generator) writeRegistry(w io.Writer) error {
+	for _, name := range registryNames {
+		if _, ok := g.typeMap[name]; ok {
+			return errorf(nil, "type name %s conflicts with the operations "+
+				"registry; choose a different typename or fragment name", name)
+		}
+		for _, op := range g.Operations {
+			if op.GoName == name {
+				return errorf(nil, "operation %s conflicts with the operations "+
+					"registry; choose a different operation name", op.Name)
+			}
+		}
+	}
+
+	fmt.Fprintf(w, "\n// OperationsByName maps the name of each operation in this package to\n"+
+		"// its document, exactly as it's sent to the server.\n")
+	fmt.Fprintf(w, "var OperationsByName = map[string]string{\n")
+	for _, op := range g.Operations {
+		fmt.Fprintf(w, "%s: %s_Operation,\n", strconv.Quote(op.Name), op.GoName)
+	}
+	fmt.Fprintf(w, "}\n")
+
+	fmt.Fprintf(w, "\n// OperationsByHash maps the hex-encoded SHA-256 hash of the document of\n"+
+		"// each operation in this package (as in OperationsByName) to the document.\n")
+	fmt.Fprintf(w, "var OperationsByHash = map[string]string{\n")
+	for _, op := range g.Operations {
+		fmt.Fprintf(w, "%s: %s_Operation,\n", strconv.Quote(operationHash(op)), op.GoName)
+	}
+	fmt.Fprintf(w, "}\n")
+	return nil
+}
@@ -0,0 +1,187 @@
+package generate
+
+// This file generates the federation representation helpers enabled by the
+// generate_representations option.  For each @key of each entity type in the
+// schema, we generate a function which takes the key fields and returns the
+// representation to pass to _entities.  For example, for
+//	type Video @key(fields: "url parent { id }") { ... }
+// we generate
+//	func VideoRepresentation(url string, parentId string) graphql.Representation {
+//		return graphql.Representation{
+//			"__typename": "Video",
+//			"url":        url,
+//			"parent":     map[string]interface{}{"id": parentId},
+//		}
+//	}
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// representationParam is a parameter of a representation helper: a key
+// field, or a field of a nested key field.
+type representationParam struct {
+	// The field's path, in camel-case, e.g. parentId.
+	name string
+	// The Go parameter-name, which is name unless that's a Go keyword.
+	varName string
+	typ     goType
+}
+
+// addRepresentations generates the representation helpers for each entity
+// type in the schema, for Config.GenerateRepresentations.  Keys which we
+// can't generate a helper for are skipped, with a warning.
+func (g *generator) addRepresentations() error {
+	representationRef, err := g.ref("github.com/Khan/genqlient/graphql.Representation")
+	if err != nil {
+		return err
+	}
+
+	var buf strings.Builder
+	for _, name := range sortedKeys(g.schema.Types) {
+		def := g.schema.Types[name]
+		if def.Kind != ast.Object {
+			continue
+		}
+		for i, key := range def.Directives.ForNames("key") {
+			goName, body, params, err := g.representation(def, key, i == 0)
+			if err != nil {
+				warn(err)
+				continue
+			}
+
+			if _, ok := g.typeMap[goName]; ok {
+				return errorf(key.Position,
+					"representation helper %s conflicts with a generated type", goName)
+			}
+			for _, op := range g.Operations {
+				if op.GoName == goName {
+					return errorf(key.Position,
+						"representation helper %s conflicts with operation %s", goName, op.Name)
+				}
+			}
+
+			paramDecls := make([]string, len(params))
+			for i, param := range params {
+				paramDecls[i] = param.varName + " " + param.typ.Reference()
+			}
+
+			fmt.Fprintf(&buf, "\n// %s returns the Apollo Federation representation of\n"+
+				"// the %s with the given key fields, for use with _entities.\n",
+				goName, def.Name)
+			fmt.Fprintf(&buf, "func %s(%s) %s {\nreturn %s{\n\"__typename\": %s,\n%s}\n}\n",
+				goName, strings.Join(paramDecls, ", "), representationRef,
+				representationRef, strconv.Quote(def.Name), body)
+		}
+	}
+	g.representations = buf.String()
+	return nil
+}
+
+// representation returns the Go name, map-literal body, and parameters of
+// the representation helper for the given @key of def.  The first key's
+// helper is named e.g. UserRepresentation; others are named after their
+// fields, e.g. UserRepresentationByEmail.
+func (g *generator) representation(
+	def *ast.Definition,
+	key *ast.Directive,
+	first bool,
+) (goName, body string, params []representationParam, err error) {
+	fieldsArg := key.Arguments.ForName("fields")
+	if fieldsArg == nil || fieldsArg.Value.Kind != ast.StringValue {
+		return "", "", nil, errorf(key.Position,
+			"warning: skipping representation helper for %s: @key must have a string fields argument",
+			def.Name)
+	}
+	doc, parseErr := parser.ParseQuery(&ast.Source{Input: "{" + fieldsArg.Value.Raw + "}"})
+	if parseErr != nil || len(doc.Operations) != 1 {
+		return "", "", nil, errorf(key.Position,
+			"warning: skipping representation helper for %s: invalid @key fields %q",
+			def.Name, fieldsArg.Value.Raw)
+	}
+
+	var builder strings.Builder
+	err = g.writeRepresentationFields(&builder, def, doc.Operations[0].SelectionSet, "", &params)
+	if err != nil {
+		return "", "", nil, errorf(key.Position,
+			"warning: skipping representation helper for %s @key(fields: %q): %v",
+			def.Name, fieldsArg.Value.Raw, err)
+	}
+
+	goName = upperFirst(def.Name) + "Representation"
+	if !first {
+		goName += "By"
+		for _, param := range params {
+			goName += upperFirst(param.name)
+		}
+	}
+	return goName, builder.String(), params, nil
+}
+
+// writeRepresentationFields writes the map-literal entries for the given key
+// fields of def, and adds their parameters to params.  Nested key fields,
+// like "parent { id }", become nested maps, with parameters named like
+// parentId.
+func (g *generator) writeRepresentationFields(
+	builder *strings.Builder,
+	def *ast.Definition,
+	selectionSet ast.SelectionSet,
+	prefix string,
+	params *[]representationParam,
+) error {
+	for _, selection := range selectionSet {
+		field, ok := selection.(*ast.Field)
+		if !ok || field.Alias != field.Name || len(field.Arguments) != 0 {
+			return fmt.Errorf("key fields must be plain fields")
+		}
+		fieldDef := def.Fields.ForName(field.Name)
+		if fieldDef == nil {
+			return fmt.Errorf("%s has no field %s", def.Name, field.Name)
+		}
+		paramName := field.Name
+		if prefix != "" {
+			paramName = prefix + upperFirst(field.Name)
+		}
+
+		fieldTypeDef := g.schema.Types[fieldDef.Type.Name()]
+		if len(field.SelectionSet) != 0 {
+			if fieldTypeDef.Kind != ast.Object && fieldTypeDef.Kind != ast.Interface {
+				return fmt.Errorf("%s has a selection but is not an object", field.Name)
+			}
+			fmt.Fprintf(builder, "%s: map[string]interface{}{\n", strconv.Quote(field.Name))
+			err := g.writeRepresentationFields(
+				builder, fieldTypeDef, field.SelectionSet, paramName, params)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(builder, "},\n")
+			continue
+		}
+
+		if fieldTypeDef.Kind != ast.Scalar && fieldTypeDef.Kind != ast.Enum {
+			return fmt.Errorf("%s is an object, so needs a selection", field.Name)
+		}
+		goTyp, err := g.convertType(nil, fieldDef.Type, nil,
+			&genqlientDirective{}, &genqlientDirective{})
+		if err != nil {
+			return err
+		}
+		if opaque, ok := goTyp.Unwrap().(*goOpaqueType); ok && opaque.Marshaler != "" {
+			// A Representation is marshaled as a map, which would skip the
+			// marshaler.
+			return fmt.Errorf("%s has a custom marshaler", field.Name)
+		}
+		varName := paramName
+		if goKeywords[varName] {
+			varName += "_"
+		}
+		*params = append(*params, representationParam{name: paramName, varName: varName, typ: goTyp})
+		fmt.Fprintf(builder, "%s: %s,\n", strconv.Quote(field.Name), varName)
+	}
+	return nil
+}
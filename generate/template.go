@@ -51,3 +51,23 @@ func (g *generator) render(tmplRelFilename string, w io.Writer, data interface{}
 	}
 	return nil
 }
+
+// generatedHeader returns the comment with which the generated code begins:
+// Config.GeneratedHeader, rendered as a template with the same data as
+// header.go.tmpl, or else genqlient's default.
+func (g *generator) generatedHeader() (string, error) {
+	if g.Config.GeneratedHeader == "" {
+		return "// Code generated by github.com/Khan/genqlient, DO NOT EDIT.", nil
+	}
+	tmpl, err := template.New("generated_header").Parse(g.Config.GeneratedHeader)
+	if err != nil {
+		return "", errorf(nil, "invalid generated_header in genqlient.yaml: %v", err)
+	}
+	var buf strings.Builder
+	err = tmpl.Execute(&buf, g)
+	if err != nil {
+		return "", errorf(nil, "invalid generated_header in genqlient.yaml: %v", err)
+	}
+	header := strings.TrimRight(buf.String(), "\n")
+	return header, validateGeneratedHeader(header)
+}
@@ -0,0 +1,13 @@
+package errors
+
+const _ = `# @genqlient
+query {
+  user { id }
+}
+`
+
+const _ = `# @genqlient
+query {
+  user { name }
+}
+`
@@ -0,0 +1,19 @@
+package parsing
+
+import "example.com/genqlient"
+
+var _ = genqlient.Query(`
+	query GetUser {
+		user { id }
+	}
+`)
+
+var _ = gql(`query GetViewer { viewer { id } }`)
+
+// Not passed to a configured function, and without the marker.
+var _ = other(`query Ignored { user { id } }`)
+
+var _ = `
+	# @genqlient
+	query WithMarker { user { id } }
+`
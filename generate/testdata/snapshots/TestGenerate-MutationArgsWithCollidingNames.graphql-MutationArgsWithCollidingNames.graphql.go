@@ -68,8 +68,9 @@ func MutationArgsWithCollidingNames(
 	client string,
 ) (*MutationArgsWithCollidingNamesResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "MutationArgsWithCollidingNames",
-		Query:  MutationArgsWithCollidingNames_Operation,
+		OpName:     "MutationArgsWithCollidingNames",
+		Query:      MutationArgsWithCollidingNames_Operation,
+		IsMutation: true,
 		Variables: &__MutationArgsWithCollidingNamesInput{
 			Data:   data,
 			Req:    req,
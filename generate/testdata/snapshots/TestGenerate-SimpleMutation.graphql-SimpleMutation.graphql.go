@@ -60,8 +60,9 @@ func SimpleMutation(
 	name string,
 ) (*SimpleMutationResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "SimpleMutation",
-		Query:  SimpleMutation_Operation,
+		OpName:     "SimpleMutation",
+		Query:      SimpleMutation_Operation,
+		IsMutation: true,
 		Variables: &__SimpleMutationInput{
 			Name: name,
 		},
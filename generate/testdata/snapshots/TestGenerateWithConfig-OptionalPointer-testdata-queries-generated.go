@@ -34,6 +34,48 @@ type ListInputQueryUser struct {
 // GetId returns ListInputQueryUser.Id, and is useful for accessing the field via an interface.
 func (v *ListInputQueryUser) GetId() string { return v.Id }
 
+// ListNullabilityResponse is returned by ListNullability on success.
+type ListNullabilityResponse struct {
+	UsersByEmails []*ListNullabilityUsersByEmailsUser `json:"usersByEmails"`
+}
+
+// GetUsersByEmails returns ListNullabilityResponse.UsersByEmails, and is useful for accessing the field via an interface.
+func (v *ListNullabilityResponse) GetUsersByEmails() []*ListNullabilityUsersByEmailsUser {
+	return v.UsersByEmails
+}
+
+// ListNullabilityUsersByEmailsUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type ListNullabilityUsersByEmailsUser struct {
+	// id is the user's ID.
+	//
+	// It is stable, unique, and opaque, like all good IDs.
+	Id                    string    `json:"id"`
+	Emails                []string  `json:"emails"`
+	EmailsOrNull          []string  `json:"emailsOrNull"`
+	EmailsWithNulls       []*string `json:"emailsWithNulls"`
+	EmailsWithNullsOrNull []*string `json:"emailsWithNullsOrNull"`
+}
+
+// GetId returns ListNullabilityUsersByEmailsUser.Id, and is useful for accessing the field via an interface.
+func (v *ListNullabilityUsersByEmailsUser) GetId() string { return v.Id }
+
+// GetEmails returns ListNullabilityUsersByEmailsUser.Emails, and is useful for accessing the field via an interface.
+func (v *ListNullabilityUsersByEmailsUser) GetEmails() []string { return v.Emails }
+
+// GetEmailsOrNull returns ListNullabilityUsersByEmailsUser.EmailsOrNull, and is useful for accessing the field via an interface.
+func (v *ListNullabilityUsersByEmailsUser) GetEmailsOrNull() []string { return v.EmailsOrNull }
+
+// GetEmailsWithNulls returns ListNullabilityUsersByEmailsUser.EmailsWithNulls, and is useful for accessing the field via an interface.
+func (v *ListNullabilityUsersByEmailsUser) GetEmailsWithNulls() []*string { return v.EmailsWithNulls }
+
+// GetEmailsWithNullsOrNull returns ListNullabilityUsersByEmailsUser.EmailsWithNullsOrNull, and is useful for accessing the field via an interface.
+func (v *ListNullabilityUsersByEmailsUser) GetEmailsWithNullsOrNull() []*string {
+	return v.EmailsWithNullsOrNull
+}
+
 // QueryWithSlicesResponse is returned by QueryWithSlices on success.
 type QueryWithSlicesResponse struct {
 	// user looks up a user by some stuff.
@@ -139,6 +181,26 @@ type __ListInputQueryInput struct {
 // GetNames returns __ListInputQueryInput.Names, and is useful for accessing the field via an interface.
 func (v *__ListInputQueryInput) GetNames() []*string { return v.Names }
 
+// __ListNullabilityInput is used internally by genqlient
+type __ListNullabilityInput struct {
+	Emails                []string  `json:"emails"`
+	EmailsOrNull          []string  `json:"emailsOrNull"`
+	EmailsWithNulls       []*string `json:"emailsWithNulls"`
+	EmailsWithNullsOrNull []*string `json:"emailsWithNullsOrNull"`
+}
+
+// GetEmails returns __ListNullabilityInput.Emails, and is useful for accessing the field via an interface.
+func (v *__ListNullabilityInput) GetEmails() []string { return v.Emails }
+
+// GetEmailsOrNull returns __ListNullabilityInput.EmailsOrNull, and is useful for accessing the field via an interface.
+func (v *__ListNullabilityInput) GetEmailsOrNull() []string { return v.EmailsOrNull }
+
+// GetEmailsWithNulls returns __ListNullabilityInput.EmailsWithNulls, and is useful for accessing the field via an interface.
+func (v *__ListNullabilityInput) GetEmailsWithNulls() []*string { return v.EmailsWithNulls }
+
+// GetEmailsWithNullsOrNull returns __ListNullabilityInput.EmailsWithNullsOrNull, and is useful for accessing the field via an interface.
+func (v *__ListNullabilityInput) GetEmailsWithNullsOrNull() []*string { return v.EmailsWithNullsOrNull }
+
 // The query or mutation executed by ListInputQuery.
 const ListInputQuery_Operation = `
 query ListInputQuery ($names: [String]) {
@@ -174,6 +236,53 @@ func ListInputQuery(
 	return &data_, err_
 }
 
+// The query or mutation executed by ListNullability.
+const ListNullability_Operation = `
+query ListNullability ($emails: [String!]!, $emailsOrNull: [String!], $emailsWithNulls: [String]!, $emailsWithNullsOrNull: [String]) {
+	usersByEmails(emails: $emails, emailsOrNull: $emailsOrNull, emailsWithNulls: $emailsWithNulls, emailsWithNullsOrNull: $emailsWithNullsOrNull) {
+		id
+		emails
+		emailsOrNull
+		emailsWithNulls
+		emailsWithNullsOrNull
+	}
+}
+`
+
+// Each combination of list and element nullability, in both input and output
+// positions.
+func ListNullability(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	emails []string,
+	emailsOrNull []string,
+	emailsWithNulls []*string,
+	emailsWithNullsOrNull []*string,
+) (*ListNullabilityResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ListNullability",
+		Query:  ListNullability_Operation,
+		Variables: &__ListNullabilityInput{
+			Emails:                emails,
+			EmailsOrNull:          emailsOrNull,
+			EmailsWithNulls:       emailsWithNulls,
+			EmailsWithNullsOrNull: emailsWithNullsOrNull,
+		},
+	}
+	var err_ error
+
+	var data_ ListNullabilityResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by QueryWithSlices.
 const QueryWithSlices_Operation = `
 query QueryWithSlices {
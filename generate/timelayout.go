@@ -0,0 +1,95 @@
+package generate
+
+// This file generates the marshalers and unmarshalers for scalars bound to
+// time.Time with a time_layout (see TypeBinding.TimeLayout).  For example,
+// for a binding
+//	Date:
+//	  time_layout: "2006-01-02"
+// we generate __marshalTimeDate and __unmarshalTimeDate, which convert
+// between time.Time and JSON strings like "2006-01-02", and use them just as
+// we would a user-specified marshaler and unmarshaler.
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// The special time_layout values for times represented as numbers, rather
+// than formatted strings, and the time.Time method and function (and its
+// arguments) which convert to and from them.
+var unixTimeLayouts = map[string]struct{ method, function, args string }{
+	"unix":      {"Unix", "time.Unix", "n, 0"},
+	"unixmilli": {"UnixMilli", "time.UnixMilli", "n"},
+}
+
+func timeMarshalerName(graphQLName string) string {
+	return "__marshalTime" + graphQLName
+}
+
+func timeUnmarshalerName(graphQLName string) string {
+	return "__unmarshalTime" + graphQLName
+}
+
+// writeTimeLayoutMarshalers writes the marshaler and unmarshaler for each
+// scalar in g.timeLayouts.
+func (g *generator) writeTimeLayoutMarshalers(w io.Writer) error {
+	if len(g.timeLayouts) == 0 {
+		return nil
+	}
+	timeRef, err := g.ref("time.Time")
+	if err != nil {
+		return err
+	}
+	jsonMarshal, err := g.ref("encoding/json.Marshal")
+	if err != nil {
+		return err
+	}
+	jsonUnmarshal, err := g.ref("encoding/json.Unmarshal")
+	if err != nil {
+		return err
+	}
+
+	for _, name := range sortedKeys(g.timeLayouts) {
+		layout := g.timeLayouts[name]
+		marshaler, unmarshaler := timeMarshalerName(name), timeUnmarshalerName(name)
+		unix, isUnix := unixTimeLayouts[layout]
+
+		var description, marshal string
+		if isUnix {
+			description = fmt.Sprintf("as a Unix time (time_layout: %s)", layout)
+			marshal = fmt.Sprintf("v.%s()", unix.method)
+		} else {
+			description = fmt.Sprintf("in the layout %s", strconv.Quote(layout))
+			marshal = fmt.Sprintf("v.Format(%s)", strconv.Quote(layout))
+		}
+
+		writeDescription(w, fmt.Sprintf(
+			"%s marshals a %s (bound to %s)\n%s.  The zero time is marshaled as null.",
+			marshaler, name, timeRef, description))
+		fmt.Fprintf(w, "func %s(v *%s) ([]byte, error) {\n", marshaler, timeRef)
+		fmt.Fprintf(w, "if v.IsZero() {\nreturn []byte(\"null\"), nil\n}\n")
+		fmt.Fprintf(w, "return %s(%s)\n}\n\n", jsonMarshal, marshal)
+
+		writeDescription(w, fmt.Sprintf(
+			"%s unmarshals a %s (bound to %s)\n%s.",
+			unmarshaler, name, timeRef, description))
+		fmt.Fprintf(w, "func %s(b []byte, v *%s) error {\n", unmarshaler, timeRef)
+		if isUnix {
+			unixFunc, err := g.ref(unix.function)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "var n int64\nerr := %s(b, &n)\nif err != nil {\nreturn err\n}\n", jsonUnmarshal)
+			fmt.Fprintf(w, "*v = %s(%s)\nreturn nil\n}\n\n", unixFunc, unix.args)
+		} else {
+			timeParse, err := g.ref("time.Parse")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "var s string\nerr := %s(b, &s)\nif err != nil {\nreturn err\n}\n", jsonUnmarshal)
+			fmt.Fprintf(w, "*v, err = %s(%s, s)\nreturn err\n}\n\n", timeParse, strconv.Quote(layout))
+		}
+	}
+	return nil
+}
@@ -50,6 +50,7 @@ type goType interface {
 
 var (
 	_ goType = (*goOpaqueType)(nil)
+	_ goType = (*goStringerType)(nil)
 	_ goType = (*goSliceType)(nil)
 	_ goType = (*goPointerType)(nil)
 	_ goType = (*goEnumType)(nil)
@@ -65,6 +66,9 @@ type (
 		GoRef                  string
 		GraphQLName            string
 		Marshaler, Unmarshaler string
+		// True if Marshaler and Unmarshaler are generated in this package
+		// (see timelayout.go), rather than fully-qualified.
+		LocalMarshalers bool
 	}
 	// goTypenameForBuiltinType represents a builtin type that was
 	// given a different name due to a `typename` directive.  We
@@ -74,6 +78,17 @@ type (
 		GoBuiltinName string
 		GraphQLName   string
 	}
+	// goStringerType represents a wrapper around a bound type which
+	// implements fmt.Stringer, generated if requested by
+	// TypeBinding.Stringer.  We create a type like
+	// `type DateTimeStringer struct{ Value time.Time }` for it.
+	goStringerType struct {
+		GoName      string
+		GraphQLName string
+		BoundRef    string
+		// If empty, delegate to the bound type's String method.
+		StringerRef string
+	}
 	// goSliceType represents the Go type []Elem, used to represent GraphQL
 	// list types.
 	goSliceType struct{ Elem goType }
@@ -94,14 +109,46 @@ func (typ *goOpaqueType) WriteDefinition(io.Writer, *generator) error { return n
 
 func (typ *goTypenameForBuiltinType) WriteDefinition(w io.Writer, g *generator) error {
 	fmt.Fprintf(w, "type %s %s", typ.GoTypeName, typ.GoBuiltinName)
+
+	// We only do this for string types: for others, implementing
+	// encoding.TextMarshaler would cause encoding/json to quote them.
+	if g.Config.GenerateTextMarshalers && typ.GoBuiltinName == "string" {
+		fmt.Fprintf(w, "\n\n")
+		writeDescription(w, fmt.Sprintf(
+			"MarshalText implements encoding.TextMarshaler for %s.", typ.GoTypeName))
+		fmt.Fprintf(w, "func (v %s) MarshalText() ([]byte, error) { return []byte(v), nil }\n",
+			typ.GoTypeName)
+		writeDescription(w, fmt.Sprintf(
+			"UnmarshalText implements encoding.TextUnmarshaler for %s.", typ.GoTypeName))
+		fmt.Fprintf(w, "func (v *%s) UnmarshalText(b []byte) error { *v = %s(b); return nil }\n",
+			typ.GoTypeName, typ.GoTypeName)
+	}
+	return nil
+}
+
+func (typ *goStringerType) WriteDefinition(w io.Writer, g *generator) error {
+	writeDescription(w, fmt.Sprintf(
+		"%s wraps a %s (bound to %s)\n"+
+			"to implement fmt.Stringer, for example for logging.",
+		typ.GoName, typ.GraphQLName, typ.BoundRef))
+	fmt.Fprintf(w, "type %s struct{ Value %s }\n\n", typ.GoName, typ.BoundRef)
+	writeDescription(w, fmt.Sprintf("String implements fmt.Stringer for %s.", typ.GoName))
+	if typ.StringerRef == "" {
+		fmt.Fprintf(w, "func (v %s) String() string { return v.Value.String() }\n", typ.GoName)
+	} else {
+		fmt.Fprintf(w, "func (v %s) String() string { return %s(v.Value) }\n",
+			typ.GoName, typ.StringerRef)
+	}
 	return nil
 }
+
 func (typ *goSliceType) WriteDefinition(io.Writer, *generator) error   { return nil }
 func (typ *goPointerType) WriteDefinition(io.Writer, *generator) error { return nil }
 func (typ *goGenericType) WriteDefinition(io.Writer, *generator) error { return nil }
 
 func (typ *goOpaqueType) Reference() string             { return typ.GoRef }
 func (typ *goTypenameForBuiltinType) Reference() string { return typ.GoTypeName }
+func (typ *goStringerType) Reference() string           { return typ.GoName }
 func (typ *goSliceType) Reference() string              { return "[]" + typ.Elem.Reference() }
 func (typ *goPointerType) Reference() string            { return "*" + typ.Elem.Reference() }
 func (typ *goGenericType) Reference() string {
@@ -110,12 +157,14 @@ func (typ *goGenericType) Reference() string {
 
 func (typ *goOpaqueType) SelectionSet() ast.SelectionSet             { return nil }
 func (typ *goTypenameForBuiltinType) SelectionSet() ast.SelectionSet { return nil }
+func (typ *goStringerType) SelectionSet() ast.SelectionSet           { return nil }
 func (typ *goSliceType) SelectionSet() ast.SelectionSet              { return typ.Elem.SelectionSet() }
 func (typ *goPointerType) SelectionSet() ast.SelectionSet            { return typ.Elem.SelectionSet() }
 func (typ *goGenericType) SelectionSet() ast.SelectionSet            { return typ.Elem.SelectionSet() }
 
 func (typ *goOpaqueType) GraphQLTypeName() string             { return typ.GraphQLName }
 func (typ *goTypenameForBuiltinType) GraphQLTypeName() string { return typ.GraphQLName }
+func (typ *goStringerType) GraphQLTypeName() string           { return typ.GraphQLName }
 func (typ *goSliceType) GraphQLTypeName() string              { return typ.Elem.GraphQLTypeName() }
 func (typ *goPointerType) GraphQLTypeName() string            { return typ.Elem.GraphQLTypeName() }
 func (typ *goGenericType) GraphQLTypeName() string            { return typ.Elem.GraphQLTypeName() }
@@ -147,6 +196,16 @@ func (typ *goEnumType) WriteDefinition(w io.Writer, g *generator) error {
 			val.GoName, typ.GoName, val.GraphQLName)
 	}
 	fmt.Fprintf(w, ")\n")
+
+	if g.Config.GenerateEnumValueLists {
+		fmt.Fprintf(w, "\n// All%sValues lists the values of %s, in schema order.\n",
+			typ.GoName, typ.GoName)
+		fmt.Fprintf(w, "var All%sValues = []%s{\n", typ.GoName, typ.GoName)
+		for _, val := range typ.Values {
+			fmt.Fprintf(w, "%s,\n", val.GoName)
+		}
+		fmt.Fprintf(w, "}\n")
+	}
 	return nil
 }
 
@@ -160,7 +219,13 @@ type goStructType struct {
 	GoName    string
 	Fields    []*goStructField
 	IsInput   bool
+	OneOf     bool // only used on input types
 	Selection ast.SelectionSet
+	// If set, this is a named fragment for which we also write an interface
+	// with its getters (see Config.GenerateFragmentInterfaces).
+	FragmentInterface bool
+	// Methods to write for @genqlient(computed: ...); see computed.go.
+	Computed []*computedMethod
 	descriptionInfo
 	Generator *generator // for the convenience of the template
 }
@@ -171,7 +236,42 @@ type goStructField struct {
 	JSONName    string // i.e. the field's alias in this query
 	GraphQLName string // i.e. the field's name in its type-def
 	Omitempty   bool   // only used on input types
+	JSONTag     string // from @genqlient(jsonTag: ...), replaces the default
 	Description string
+	// only used on input types (and only set for input-object fields), and
+	// by generate_completeness_check (for which conditional fields are
+	// nullable)
+	GraphQLType *ast.Type
+	// only used on optional fragments (see IsOptionalEmbed); the JSON names
+	// whose presence means the fragment was included
+	PresenceKeys []string
+}
+
+// writeFragmentInterface writes the interface for a named fragment (see
+// Config.GenerateFragmentInterfaces), whose flattened fields are given.
+func (typ *goStructType) writeFragmentInterface(w io.Writer, flattened []*selector) {
+	name := typ.GoName + "Interface"
+	writeDescription(w, fmt.Sprintf(
+		"%s is implemented by (pointers to) %s and each type which spreads "+
+			"the %s fragment, for code which accepts any of them.",
+		name, typ.GoName, typ.FragmentName))
+	fmt.Fprintf(w, "type %s interface {\n", name)
+	for _, field := range flattened {
+		fmt.Fprintf(w, "\tGet%s() %s\n", field.GoName, field.GoType.Reference())
+	}
+	fmt.Fprintf(w, "}\n")
+}
+
+// JSONTagValue returns the value of the json struct-tag for this field
+// (without quotes), namely its JSON name and options.
+func (field *goStructField) JSONTagValue() string {
+	if field.JSONTag != "" {
+		return field.JSONTag
+	}
+	if field.Omitempty {
+		return field.JSONName + ",omitempty"
+	}
+	return field.JSONName
 }
 
 // IsAbstract returns true if this field is of abstract type (i.e. GraphQL
@@ -187,15 +287,24 @@ func (field *goStructField) IsEmbedded() bool {
 	return field.GoName == ""
 }
 
+// IsOptionalEmbed returns true if this field is an embedded pointer, which
+// corresponds to a fragment spread with @genqlient(optionalFragment: ...);
+// see optionalfragments.go.
+func (field *goStructField) IsOptionalEmbed() bool {
+	return field.IsEmbedded() && field.GoType.IsPointer()
+}
+
 // Selector returns the field's name, which is unqualified type-name if it's
 // embedded.
 func (field *goStructField) Selector() string {
 	if field.GoName != "" {
 		return field.GoName
 	}
-	// TODO(benkraft): This assumes the type is package-local, which is always
-	// true for embedded types for us, but isn't the most robust assumption.
-	return field.GoType.Unwrap().Reference()
+	// Embedded types may come from the fragments_package (see
+	// Config.FragmentsPackage), in which case the selector is the unqualified
+	// type-name.
+	ref := field.GoType.Unwrap().Reference()
+	return ref[strings.LastIndex(ref, ".")+1:]
 }
 
 // unmarshaler returns:
@@ -208,7 +317,7 @@ func (field *goStructField) unmarshaler() (qualifiedName string, needsImport boo
 	switch typ := field.GoType.Unwrap().(type) {
 	case *goOpaqueType:
 		if typ.Unmarshaler != "" {
-			return typ.Unmarshaler, true, true
+			return typ.Unmarshaler, !typ.LocalMarshalers, true
 		}
 	case *goInterfaceType:
 		return "__unmarshal" + typ.Reference(), false, true
@@ -234,7 +343,7 @@ func (field *goStructField) marshaler() (qualifiedName string, needsImport bool,
 	switch typ := field.GoType.Unwrap().(type) {
 	case *goOpaqueType:
 		if typ.Marshaler != "" {
-			return typ.Marshaler, true, true
+			return typ.Marshaler, !typ.LocalMarshalers, true
 		}
 	case *goInterfaceType:
 		return "__marshal" + typ.Reference(), false, true
@@ -280,6 +389,10 @@ type selector struct {
 	*goStructField
 	// e.g. "OuterEmbed.InnerEmbed.LeafField"
 	Selector string
+	// If we reach this field via an optional fragment (which may be nil; see
+	// goStructField.IsOptionalEmbed), the selector for that fragment, e.g.
+	// "OuterEmbed".  Else "".
+	OptionalEmbed string
 }
 
 // FlattenedFields returns the fields of this type and its recursive embeds,
@@ -334,7 +447,7 @@ func (typ *goStructType) FlattenedFields() ([]*selector, error) {
 
 	queue := make([]*selector, len(typ.Fields))
 	for i, field := range typ.Fields {
-		queue[i] = &selector{field, field.Selector()}
+		queue[i] = &selector{field, field.Selector(), ""}
 	}
 
 	// Since our (non-embedded) fields always have JSON tags, the logic we want
@@ -344,7 +457,7 @@ func (typ *goStructType) FlattenedFields() ([]*selector, error) {
 		field := queue[0]
 		queue = queue[1:]
 		if field.IsEmbedded() {
-			typ, ok := field.GoType.(*goStructType)
+			typ, ok := field.GoType.Unwrap().(*goStructType)
 			if !ok {
 				// Should never happen: embeds correspond to named fragments,
 				// and even if the fragment is of interface type in GraphQL,
@@ -357,10 +470,15 @@ func (typ *goStructType) FlattenedFields() ([]*selector, error) {
 					typ.GoName, field.GoName)
 			}
 
+			optionalEmbed := field.OptionalEmbed
+			if optionalEmbed == "" && field.IsOptionalEmbed() {
+				optionalEmbed = field.Selector
+			}
+
 			// Enqueue the embedded fields for our BFS.
 			for _, subField := range typ.Fields {
-				queue = append(queue,
-					&selector{subField, field.Selector + "." + subField.Selector()})
+				queue = append(queue, &selector{
+					subField, field.Selector + "." + subField.Selector(), optionalEmbed})
 			}
 			continue
 		}
@@ -377,18 +495,68 @@ func (typ *goStructType) FlattenedFields() ([]*selector, error) {
 	return retval, nil
 }
 
+// optionalEmbed is an optional fragment embedded in a struct (see
+// goStructField.IsOptionalEmbed), along with the flattened fields (see
+// FlattenedFields) we reach via it.
+type optionalEmbed struct {
+	Selector string
+	Fields   []*selector
+}
+
+// HasOptionalEmbeds returns true if any of this type's fields are optional
+// fragments.
+func (typ *goStructType) HasOptionalEmbeds() bool {
+	for _, field := range typ.Fields {
+		if field.IsOptionalEmbed() {
+			return true
+		}
+	}
+	return false
+}
+
+// OptionalEmbeds returns the optional fragments embedded in this type, for
+// the benefit of MarshalJSON, which must marshal their fields only if they
+// are non-nil.
+func (typ *goStructType) OptionalEmbeds() ([]*optionalEmbed, error) {
+	flattened, err := typ.FlattenedFields()
+	if err != nil {
+		return nil, err
+	}
+
+	var retval []*optionalEmbed
+	for _, field := range typ.Fields {
+		if !field.IsOptionalEmbed() {
+			continue
+		}
+		embed := &optionalEmbed{Selector: field.Selector()}
+		for _, flattenedField := range flattened {
+			if flattenedField.OptionalEmbed == embed.Selector {
+				embed.Fields = append(embed.Fields, flattenedField)
+			}
+		}
+		retval = append(retval, embed)
+	}
+	return retval, nil
+}
+
 func (typ *goStructType) WriteDefinition(w io.Writer, g *generator) error {
 	writeDescription(w, structDescription(typ))
 
 	fmt.Fprintf(w, "type %s struct {\n", typ.GoName)
 	for _, field := range typ.Fields {
 		writeDescription(w, field.Description)
-		jsonTag := `"` + field.JSONName
-		if field.Omitempty {
-			jsonTag += ",omitempty"
-		}
-		jsonTag += `"`
+		jsonTag := `"` + field.JSONTagValue() + `"`
 		if field.NeedsMarshaling() {
+			if field.JSONTag != "" {
+				return errorf(nil, "jsonTag may not be used on %s.%s, which genqlient (un)marshals itself",
+					typ.GoName, field.GoName)
+			}
+			if ptr, ok := field.GoType.(*goPointerType); ok {
+				if _, ok := ptr.Elem.(*goSliceType); ok {
+					return errorf(nil, "slicePointer may not be used on %s.%s, which genqlient (un)marshals itself",
+						typ.GoName, field.GoName)
+				}
+			}
 			// certain types are handled in our (Un)MarshalJSON (see below)
 			jsonTag = `"-"`
 		}
@@ -414,14 +582,79 @@ func (typ *goStructType) WriteDefinition(w io.Writer, g *generator) error {
 		return err
 	}
 	for _, field := range flattened {
+		if field.OptionalEmbed != "" {
+			// The optional fragment may be nil, so we write a getter which
+			// checks (and which shadows the fragment's own getter, which
+			// would otherwise be promoted, and panic).
+			writeDescription(w, fmt.Sprintf(
+				"Get%s returns %s.%s, or the zero value if the optional "+
+					"fragment %s was not included.",
+				field.GoName, typ.GoName, field.GoName, field.OptionalEmbed))
+			fmt.Fprintf(w, "func (v *%s) Get%s() (retval %s) {\n"+
+				"\tif v.%s != nil {\n\t\tretval = v.%s\n\t}\n\treturn retval\n}\n",
+				typ.GoName, field.GoName, field.GoType.Reference(),
+				field.OptionalEmbed, field.Selector)
+			continue
+		}
 		description := fmt.Sprintf(
 			"Get%s returns %s.%s, and is useful for accessing the field via an interface.",
 			field.GoName, typ.GoName, field.GoName)
+		if g.Config.CopyDescriptions && field.Description != "" {
+			description = fmt.Sprintf(
+				"%s\nThe GraphQL field's documentation follows.\n\n%s",
+				description, field.Description)
+		}
 		writeDescription(w, description)
 		fmt.Fprintf(w, "func (v *%s) Get%s() %s { return v.%s }\n",
 			typ.GoName, field.GoName, field.GoType.Reference(), field.Selector)
 	}
 
+	if typ.FragmentInterface {
+		typ.writeFragmentInterface(w, flattened)
+	}
+
+	typ.writeComputed(w)
+
+	if g.Config.GenerateWalk && !typ.IsInput {
+		if err := typ.writeWalk(w, g); err != nil {
+			return err
+		}
+	}
+
+	if g.Config.GenerateCompletenessCheck && !typ.IsInput {
+		if err := typ.writeIsComplete(w); err != nil {
+			return err
+		}
+	}
+
+	if g.Config.GenerateInputBuilders && typ.IsInput && !typ.isOperationInput() {
+		typ.writeBuilders(w)
+	}
+	if g.Config.GenerateOneOfConstructors && typ.OneOf && typ.IsInput && !typ.isOperationInput() {
+		typ.writeOneOfConstructors(w)
+	}
+	if g.Config.GenerateInputMerge && typ.IsInput {
+		if err := typ.writeMerge(w, g); err != nil {
+			return err
+		}
+	}
+	if g.Config.GenerateValidation && typ.IsInput && !typ.isOperationInput() {
+		if err := typ.writeValidate(w, g); err != nil {
+			return err
+		}
+	}
+	if g.Config.GenerateUploadListers && !g.Config.TypesOnly {
+		needed, err := typ.needsListUploads(g)
+		if err != nil {
+			return err
+		}
+		if needed {
+			if err := typ.writeListUploads(w, g); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Now, if needed, write the marshaler/unmarshaler.  We need one if we have
 	// any interface-typed fields, or any embedded fields.
 	//
@@ -467,6 +700,99 @@ func (typ *goStructType) WriteDefinition(w io.Writer, g *generator) error {
 	return nil
 }
 
+// isOperationInput returns true if this is the type genqlient generates to
+// hold an operation's variables (see convertArguments), rather than a
+// GraphQL input-object type.
+func (typ *goStructType) isOperationInput() bool {
+	return strings.HasPrefix(typ.GoName, "__")
+}
+
+// writeBuilders writes a constructor and chainable setter methods for an
+// input type, enabled by the generate_input_builders option.  Slice-typed
+// fields get a variadic setter, which makes logical operators read nicely:
+//
+//	NewUserBoolExp().WithAnd(
+//		NewUserBoolExp().WithName(...),
+//		NewUserBoolExp().WithAge(...))
+func (typ *goStructType) writeBuilders(w io.Writer) {
+	writeDescription(w, fmt.Sprintf(
+		"New%s returns a new, empty %s, whose fields may be set with its With* methods.",
+		typ.GoName, typ.GoName))
+	fmt.Fprintf(w, "func New%s() *%s { return &%s{} }\n", typ.GoName, typ.GoName, typ.GoName)
+
+	for _, field := range typ.Fields {
+		writeDescription(w, fmt.Sprintf(
+			"With%s sets %s.%s, and returns the receiver to allow chaining.",
+			field.GoName, typ.GoName, field.GoName))
+		if slice, ok := field.GoType.(*goSliceType); ok {
+			fmt.Fprintf(w, "func (v *%s) With%s(values ...%s) *%s { v.%s = values; return v }\n",
+				typ.GoName, field.GoName, slice.Elem.Reference(), typ.GoName, field.GoName)
+		} else {
+			fmt.Fprintf(w, "func (v *%s) With%s(value %s) *%s { v.%s = value; return v }\n",
+				typ.GoName, field.GoName, field.GoType.Reference(), typ.GoName, field.GoName)
+		}
+	}
+}
+
+// writeOneOfConstructors writes a constructor for each field of a @oneOf
+// input type (see Config.GenerateOneOfConstructors), which returns a value
+// with only that field set.
+func (typ *goStructType) writeOneOfConstructors(w io.Writer) {
+	for _, field := range typ.Fields {
+		name := "New" + typ.GoName + "By" + field.GoName
+		writeDescription(w, fmt.Sprintf(
+			"%s returns a %s with only %s set, as required by @oneOf.",
+			name, typ.GoName, field.GoName))
+		switch goTyp := field.GoType.(type) {
+		case *goPointerType:
+			fmt.Fprintf(w, "func %s(value %s) %s { return %s{%s: &value} }\n",
+				name, goTyp.Elem.Reference(), typ.GoName, typ.GoName, field.GoName)
+		default:
+			if _, ok := goTyp.(*goSliceType); !ok && !field.Omitempty {
+				// The zero values of the other fields will be sent too, so the
+				// constructor doesn't help much.
+				warn(errorf(nil, "warning: field %s of @oneOf input type %s is neither "+
+					"a pointer nor omitempty, so its zero value will be sent; "+
+					"consider `optional: pointer`",
+					field.GraphQLName, typ.GraphQLName))
+			}
+			fmt.Fprintf(w, "func %s(value %s) %s { return %s{%s: value} }\n",
+				name, goTyp.Reference(), typ.GoName, typ.GoName, field.GoName)
+		}
+	}
+}
+
+// writeMerge writes the Merge method for an input type, or an operation's
+// variables (see Config.GenerateInputMerge).
+func (typ *goStructType) writeMerge(w io.Writer, g *generator) error {
+	for _, field := range typ.Fields {
+		if field.GoName == "Merge" {
+			return errorf(nil, "generate_input_merge: %s has a field Merge, "+
+				"which conflicts with its Merge method", typ.GoName)
+		}
+	}
+	writeDescription(w,
+		"Merge returns a copy of v with each field which is set in override "+
+			"(that is, non-nil, or for non-pointer fields, non-zero) replaced "+
+			"by override's value.  Nested input-type values are merged recursively.")
+	fmt.Fprintf(w, "func (v %s) Merge(override %s) %s {\n", typ.GoName, typ.GoName, typ.GoName)
+	for _, field := range typ.Fields {
+		if nested, ok := field.GoType.(*goStructType); ok && nested.IsInput {
+			fmt.Fprintf(w, "\tv.%s = v.%s.Merge(override.%s)\n",
+				field.GoName, field.GoName, field.GoName)
+			continue
+		}
+		isSet, err := isSetExpr(g, "override."+field.GoName, field.GoType)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "\tif %s {\n\t\tv.%s = override.%s\n\t}\n",
+			isSet, field.GoName, field.GoName)
+	}
+	fmt.Fprintf(w, "\treturn v\n}\n")
+	return nil
+}
+
 func (typ *goStructType) Reference() string              { return typ.GoName }
 func (typ *goStructType) SelectionSet() ast.SelectionSet { return typ.Selection }
 func (typ *goStructType) GraphQLTypeName() string        { return typ.GraphQLName }
@@ -516,6 +842,16 @@ func (typ *goInterfaceType) WriteDefinition(w io.Writer, g *generator) error {
 		writeDescription(w, description)
 		fmt.Fprintf(w, "\t%s() %s\n", methodName, sharedField.GoType.Reference())
 	}
+	if g.Config.GenerateWalk {
+		writeDescription(w, "Walk calls fn on each field of the implementation; "+
+			"see the implementations' Walk methods.")
+		fmt.Fprintf(w, "\t%s\n", walkSignature)
+	}
+	if g.Config.GenerateCompletenessCheck {
+		writeDescription(w, "IsComplete returns whether each non-null field of the "+
+			"implementation is set; see the implementations' IsComplete methods.")
+		fmt.Fprintf(w, "\t%s\n", isCompleteSignature)
+	}
 	fmt.Fprintf(w, "}\n")
 
 	// Now, write out the implementations.
@@ -540,6 +876,7 @@ func (typ *goInterfaceType) GraphQLTypeName() string        { return typ.GraphQL
 
 func (typ *goOpaqueType) Unwrap() goType             { return typ }
 func (typ *goTypenameForBuiltinType) Unwrap() goType { return typ }
+func (typ *goStringerType) Unwrap() goType           { return typ }
 func (typ *goSliceType) Unwrap() goType              { return typ.Elem.Unwrap() }
 func (typ *goPointerType) Unwrap() goType            { return typ.Elem.Unwrap() }
 func (typ *goGenericType) Unwrap() goType            { return typ.Elem.Unwrap() }
@@ -549,6 +886,7 @@ func (typ *goInterfaceType) Unwrap() goType          { return typ }
 
 func (typ *goOpaqueType) SliceDepth() int             { return 0 }
 func (typ *goTypenameForBuiltinType) SliceDepth() int { return 0 }
+func (typ *goStringerType) SliceDepth() int           { return 0 }
 func (typ *goSliceType) SliceDepth() int              { return typ.Elem.SliceDepth() + 1 }
 func (typ *goPointerType) SliceDepth() int            { return 0 }
 func (typ *goGenericType) SliceDepth() int            { return 0 }
@@ -558,6 +896,7 @@ func (typ *goInterfaceType) SliceDepth() int          { return 0 }
 
 func (typ *goOpaqueType) IsPointer() bool             { return false }
 func (typ *goTypenameForBuiltinType) IsPointer() bool { return false }
+func (typ *goStringerType) IsPointer() bool           { return false }
 func (typ *goSliceType) IsPointer() bool              { return typ.Elem.IsPointer() }
 func (typ *goPointerType) IsPointer() bool            { return true }
 func (typ *goGenericType) IsPointer() bool            { return false }
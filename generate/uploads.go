@@ -0,0 +1,148 @@
+package generate
+
+// This file generates the ListUploads methods enabled by the
+// generate_upload_listers option.  They implement graphql.UploadLister, so
+// the client can find the files to upload in an operation's variables
+// without reflection.  For example, for
+//	mutation UploadFiles($input: UploadInput!) { ... }
+//	input UploadInput { name: String!, files: [Upload!]! }
+// we generate
+//	func (v *UploadInput) ListUploads(path string, add func(path string, file graphql.Upload)) {
+//		for i0, e0 := range v.Files {
+//			add(path+".files."+strconv.Itoa(i0), e0)
+//		}
+//	}
+// and a method on __UploadFilesInput which calls it with path+".input".
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+const uploadTypeRef = "github.com/Khan/genqlient/graphql.Upload"
+
+// containsUpload returns whether values of typ may contain a graphql.Upload,
+// whose Go reference is uploadRef.  seen is used to avoid infinite recursion
+// on recursive input types.
+func containsUpload(typ goType, uploadRef string, seen map[*goStructType]bool) bool {
+	switch typ := typ.(type) {
+	case *goOpaqueType:
+		return typ.GoRef == uploadRef
+	case *goPointerType:
+		return containsUpload(typ.Elem, uploadRef, seen)
+	case *goSliceType:
+		return containsUpload(typ.Elem, uploadRef, seen)
+	case *goGenericType:
+		return containsUpload(typ.Elem, uploadRef, seen)
+	case *goStructType:
+		if seen[typ] {
+			return false
+		}
+		seen[typ] = true
+		for _, field := range typ.Fields {
+			if containsUpload(field.GoType, uploadRef, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// needsListUploads returns whether we generate a ListUploads method for
+// typ: for each operation's variables type, so the client never needs
+// reflection, and for each input type which may contain uploads.
+func (typ *goStructType) needsListUploads(g *generator) (bool, error) {
+	if !typ.IsInput {
+		return false, nil
+	}
+	if typ.isOperationInput() {
+		return true, nil
+	}
+	uploadRef, err := g.ref(uploadTypeRef)
+	if err != nil {
+		return false, err
+	}
+	return containsUpload(typ, uploadRef, map[*goStructType]bool{}), nil
+}
+
+// writeListUploads writes the ListUploads method for an input type.
+func (typ *goStructType) writeListUploads(w io.Writer, g *generator) error {
+	uploadRef, err := g.ref(uploadTypeRef)
+	if err != nil {
+		return err
+	}
+
+	var body strings.Builder
+	for _, field := range typ.Fields {
+		err := writeListUploadsValue(&body, g, uploadRef, "v."+field.GoName, field.GoType,
+			fmt.Sprintf(`path+".%s"`, field.JSONName), 0)
+		if err != nil {
+			return err
+		}
+	}
+
+	writeDescription(w, fmt.Sprintf(
+		"ListUploads calls add with each %s in %s, and its path; it implements "+
+			"graphql.UploadLister.", uploadRef, typ.GoName))
+	fmt.Fprintf(w, "func (v *%s) ListUploads(path string, add func(path string, file %s)) {\n%s}\n",
+		typ.GoName, uploadRef, body.String())
+	return nil
+}
+
+// writeListUploadsValue writes the code to list the uploads in the Go
+// expression expr, of the given type, whose path is the Go expression
+// pathExpr.  depth is used to name loop variables.
+func writeListUploadsValue(
+	w io.Writer,
+	g *generator,
+	uploadRef string,
+	expr string,
+	typ goType,
+	pathExpr string,
+	depth int,
+) error {
+	if !containsUpload(typ, uploadRef, map[*goStructType]bool{}) {
+		return nil
+	}
+
+	switch typ := typ.(type) {
+	case *goOpaqueType:
+		fmt.Fprintf(w, "add(%s, %s)\n", pathExpr, expr)
+	case *goPointerType:
+		// Input structs have pointer-receiver methods, so need no deref.
+		innerExpr := "*" + expr
+		if _, ok := typ.Elem.(*goStructType); ok {
+			innerExpr = expr
+		}
+		fmt.Fprintf(w, "if %s != nil {\n", expr)
+		err := writeListUploadsValue(w, g, uploadRef, innerExpr, typ.Elem, pathExpr, depth)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "}\n")
+	case *goSliceType:
+		itoa, err := g.ref("strconv.Itoa")
+		if err != nil {
+			return err
+		}
+		index, elem := fmt.Sprintf("i%d", depth), fmt.Sprintf("e%d", depth)
+		fmt.Fprintf(w, "for %s, %s := range %s {\n", index, elem, expr)
+		elemPathExpr := fmt.Sprintf(`%s+"."+%s(%s)`, pathExpr, itoa, index)
+		if trimmed := strings.TrimSuffix(pathExpr, `"`); trimmed != pathExpr {
+			// (Append to the string literal, for readability.)
+			elemPathExpr = fmt.Sprintf(`%s."+%s(%s)`, trimmed, itoa, index)
+		}
+		err = writeListUploadsValue(w, g, uploadRef, elem, typ.Elem, elemPathExpr, depth+1)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "}\n")
+	case *goStructType:
+		fmt.Fprintf(w, "%s.ListUploads(%s, add)\n", expr, pathExpr)
+	default:
+		return errorf(nil, "generate_upload_listers does not support %s, "+
+			"which contains an Upload, since genqlient can't inspect it", typ.Reference())
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package generate
 
 import (
+	"sort"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -12,6 +13,18 @@ func reverse(slice []string) {
 	}
 }
 
+// sortedKeys returns the keys of m in sorted order.  We iterate over maps
+// this way wherever the order could affect the output (including which error
+// we report), so that generation is deterministic.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func changeFirst(s string, f func(rune) rune) string {
 	c, n := utf8.DecodeRuneInString(s)
 	if c == utf8.RuneError { // empty or invalid
@@ -28,6 +41,34 @@ func upperFirst(s string) string {
 	return changeFirst(strings.TrimLeft(s, "_"), unicode.ToUpper)
 }
 
+// splitWords splits the camel-cased identifier s into words, e.g. "userId"
+// into "user" and "Id", and "HTTPServer2" into "HTTP" and "Server2".
+// Underscores are kept, as their own words.
+func splitWords(s string) []string {
+	var words []string
+	runes := []rune(s)
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		prev, cur := runes[i-1], runes[i]
+		var next rune
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+		switch {
+		case cur == '_' || prev == '_':
+		case unicode.IsUpper(cur) && !unicode.IsUpper(prev):
+			// "userId" -> "user", "Id"
+		case unicode.IsUpper(cur) && unicode.IsLower(next):
+			// "HTTPServer" -> "HTTP", "Server"
+		default:
+			continue
+		}
+		words = append(words, string(runes[start:i]))
+		start = i
+	}
+	return append(words, string(runes[start:]))
+}
+
 func goConstName(s string) string {
 	if strings.TrimLeft(s, "_") == "" {
 		return s
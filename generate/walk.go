@@ -0,0 +1,113 @@
+package generate
+
+// This file generates the Walk methods enabled by the generate_walk option,
+// which traverse a response, calling a function on each field, for generic
+// processing such as redaction.  For a type
+//	type GetUserUser struct {
+//		Id     string
+//		Emails []string
+//	}
+// we generate
+//	func (v *GetUserUser) Walk(fn func(path string, value interface{})) {
+//		fn("id", &v.Id)
+//		fn("emails", &v.Emails)
+//		for i0 := range v.Emails {
+//			fn("emails."+strconv.Itoa(i0), &v.Emails[i0])
+//		}
+//	}
+// and similarly for nested types, whose own Walk methods we call.
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const walkSignature = "Walk(fn func(path string, value interface{}))"
+
+// writeWalk writes the Walk method for the given (response) struct type.
+func (typ *goStructType) writeWalk(w io.Writer, g *generator) error {
+	itoa, err := g.ref("strconv.Itoa")
+	if err != nil {
+		return err
+	}
+
+	writeDescription(w, fmt.Sprintf(
+		"Walk calls fn with the path and a pointer to the value of each field "+
+			"of %s, recursively; see the generate_walk option for details.",
+		typ.GoName))
+	fmt.Fprintf(w, "func (v *%s) %s {\n", typ.GoName, walkSignature)
+	for _, field := range typ.Fields {
+		expr := "v." + field.Selector()
+		if field.IsEmbedded() {
+			// Embedded fields (from fragments) share our paths.
+			if field.IsOptionalEmbed() {
+				fmt.Fprintf(w, "if %s != nil {\n%s.Walk(fn)\n}\n", expr, expr)
+			} else {
+				fmt.Fprintf(w, "%s.Walk(fn)\n", expr)
+			}
+			continue
+		}
+		writeWalkValue(w, itoa, expr, field.GoType, strconv.Quote(field.JSONName), 0)
+	}
+	fmt.Fprintf(w, "}\n")
+	return nil
+}
+
+// writeWalkValue writes the code to visit the (addressable) Go expression
+// expr, of the given type, whose path is given by the Go expression pathExpr,
+// and its contents.  depth is used to name loop variables.
+func writeWalkValue(w io.Writer, itoa, expr string, typ goType, pathExpr string, depth int) {
+	fmt.Fprintf(w, "fn(%s, &%s)\n", pathExpr, expr)
+	writeWalkContents(w, itoa, expr, typ, pathExpr, depth)
+}
+
+// writeWalkContents is like writeWalkValue, but visits only the contents of
+// expr, not expr itself.
+func writeWalkContents(w io.Writer, itoa, expr string, typ goType, pathExpr string, depth int) {
+	walkNested := fmt.Sprintf(
+		"%s.Walk(func(path string, value interface{}) { fn(%s, value) })\n",
+		expr, concatExprs(pathExpr, `"."`, "path"))
+
+	switch typ := typ.(type) {
+	case *goStructType:
+		fmt.Fprint(w, walkNested)
+	case *goInterfaceType:
+		fmt.Fprintf(w, "if %s != nil {\n%s}\n", expr, walkNested)
+	case *goPointerType:
+		var inner strings.Builder
+		// Structs' methods have pointer receivers, so need no deref.
+		innerExpr := "(*" + expr + ")"
+		if _, ok := typ.Elem.(*goStructType); ok {
+			innerExpr = expr
+		}
+		writeWalkContents(&inner, itoa, innerExpr, typ.Elem, pathExpr, depth)
+		if inner.Len() > 0 {
+			fmt.Fprintf(w, "if %s != nil {\n%s}\n", expr, inner.String())
+		}
+	case *goSliceType:
+		i := fmt.Sprintf("i%d", depth)
+		fmt.Fprintf(w, "for %s := range %s {\n", i, expr)
+		writeWalkValue(w, itoa, fmt.Sprintf("%s[%s]", expr, i), typ.Elem,
+			concatExprs(pathExpr, `"."`, fmt.Sprintf("%s(%s)", itoa, i)), depth+1)
+		fmt.Fprintf(w, "}\n")
+	default:
+		// Scalars, enums, and bound and generic types are opaque to us.
+	}
+}
+
+// concatExprs returns a Go expression concatenating the given Go string
+// expressions, merging adjacent string literals.
+func concatExprs(exprs ...string) string {
+	var parts []string
+	for _, expr := range exprs {
+		n := len(parts)
+		if n > 0 && strings.HasSuffix(parts[n-1], `"`) && strings.HasPrefix(expr, `"`) {
+			parts[n-1] = parts[n-1][:len(parts[n-1])-1] + expr[1:]
+		} else {
+			parts = append(parts, expr)
+		}
+	}
+	return strings.Join(parts, " + ")
+}
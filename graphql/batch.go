@@ -0,0 +1,151 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// A BatchFunc fetches the values for several keys at once, typically by
+// calling a genqlient-generated function which accepts a list of keys.  It
+// returns the values by key; keys with no value may be omitted.  See
+// [NewBatchLoader].
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// A BatchLoader coalesces many requests for single values into fewer
+// requests for batches of values, in the style of a DataLoader.  It's useful
+// if you need to fetch entities one at a time, for example in a loop or from
+// many goroutines, but the API can fetch many at once.
+//
+// A BatchLoader is safe for concurrent use.  Create one with
+// [NewBatchLoader].
+type BatchLoader[K comparable, V any] struct {
+	fetch    BatchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+
+	mu    sync.Mutex
+	batch *loaderBatch[K, V] // the batch currently collecting keys, if any
+}
+
+// loaderBatch is a single batch of keys, fetched together.
+type loaderBatch[K comparable, V any] struct {
+	ctx   context.Context
+	keys  []K
+	seen  map[K]bool
+	timer *time.Timer
+
+	done    chan struct{} // closed once results and err are set
+	results map[K]V
+	err     error
+}
+
+// NewBatchLoader returns a [BatchLoader] which calls fetch with the keys
+// passed to Load within wait of each other (starting from the first Load of
+// each batch), or as soon as it has maxBatch keys, if maxBatch is positive.
+// Duplicate keys are fetched once.
+//
+// For example, given a genqlient query
+//
+//	query GetUsers($ids: [ID!]!) { users(ids: $ids) { id name } }
+//
+// you might do
+//
+//	loader := graphql.NewBatchLoader(
+//		func(ctx context.Context, ids []string) (map[string]GetUsersUsersUser, error) {
+//			resp, err := GetUsers(ctx, client, ids)
+//			if err != nil {
+//				return nil, err
+//			}
+//			users := make(map[string]GetUsersUsersUser, len(resp.Users))
+//			for _, user := range resp.Users {
+//				users[user.Id] = user
+//			}
+//			return users, nil
+//		}, time.Millisecond, 100)
+//
+// and then call loader.Load(ctx, id) wherever you need a single user.
+func NewBatchLoader[K comparable, V any](
+	fetch BatchFunc[K, V],
+	wait time.Duration,
+	maxBatch int,
+) *BatchLoader[K, V] {
+	return &BatchLoader[K, V]{fetch: fetch, wait: wait, maxBatch: maxBatch}
+}
+
+// Load returns the value for the given key, waiting for (and if needed,
+// starting) the batch which fetches it.  If the batch fails, Load returns
+// its error; if fetch returns no value for key, Load returns an error.
+//
+// fetch is called with the values of the context passed to the first Load
+// of each batch, but not its cancellation or deadline, since the batch is
+// shared by the other callers.  If ctx is canceled while waiting, Load
+// returns immediately, but the batch is still fetched for the others.  (To
+// bound the time fetch takes, set a timeout within it.)
+func (l *BatchLoader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	l.mu.Lock()
+	b := l.batch
+	if b == nil {
+		b = &loaderBatch[K, V]{ctx: withoutCancel{ctx}, seen: map[K]bool{}, done: make(chan struct{})}
+		l.batch = b
+		b.timer = time.AfterFunc(l.wait, func() { l.dispatch(b) })
+	}
+	if !b.seen[key] {
+		b.seen[key] = true
+		b.keys = append(b.keys, key)
+	}
+	if l.maxBatch > 0 && len(b.keys) >= l.maxBatch {
+		l.batch = nil
+		b.timer.Stop()
+		go b.run(l.fetch)
+	}
+	l.mu.Unlock()
+
+	var zero V
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+	if b.err != nil {
+		return zero, b.err
+	}
+	value, ok := b.results[key]
+	if !ok {
+		return zero, fmt.Errorf("batch loader: no value for key %v", key)
+	}
+	return value, nil
+}
+
+// dispatch fetches the batch b when its wait is up, unless it was already
+// dispatched because it got full.
+func (l *BatchLoader[K, V]) dispatch(b *loaderBatch[K, V]) {
+	l.mu.Lock()
+	if l.batch != b {
+		l.mu.Unlock()
+		return
+	}
+	l.batch = nil
+	l.mu.Unlock()
+	b.run(l.fetch)
+}
+
+func (b *loaderBatch[K, V]) run(fetch BatchFunc[K, V]) {
+	b.results, b.err = fetch(b.ctx, b.keys)
+	close(b.done)
+}
+
+// withoutCancel is a context with the values of the wrapped context, but
+// which is never canceled and has no deadline.  (It's like
+// context.WithoutCancel, which requires Go 1.21.)
+type withoutCancel struct{ parent context.Context }
+
+func (withoutCancel) Deadline() (deadline time.Time, ok bool) { return time.Time{}, false }
+func (withoutCancel) Done() <-chan struct{}                   { return nil }
+func (withoutCancel) Err() error                              { return nil }
+func (c withoutCancel) Value(key interface{}) interface{}     { return c.parent.Value(key) }
@@ -0,0 +1,127 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loadAll calls loader.Load for each key concurrently, and returns the
+// results in order.
+func loadAll(loader *BatchLoader[int, string], keys []int) ([]string, []error) {
+	values := make([]string, len(keys))
+	errs := make([]error, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i, key int) {
+			defer wg.Done()
+			values[i], errs[i] = loader.Load(context.Background(), key)
+		}(i, key)
+	}
+	wg.Wait()
+	return values, errs
+}
+
+func TestBatchLoader(t *testing.T) {
+	var mu sync.Mutex
+	var batches [][]int
+	fetch := func(ctx context.Context, keys []int) (map[int]string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		sorted := append([]int(nil), keys...)
+		sort.Ints(sorted)
+		batches = append(batches, sorted)
+		values := make(map[int]string, len(keys))
+		for _, key := range keys {
+			if key >= 0 {
+				values[key] = strconv.Itoa(key)
+			}
+		}
+		return values, nil
+	}
+
+	t.Run("Wait", func(t *testing.T) {
+		batches = nil
+		loader := NewBatchLoader(fetch, 50*time.Millisecond, 0)
+		values, errs := loadAll(loader, []int{1, 2, 3, 2})
+		assert.Equal(t, []string{"1", "2", "3", "2"}, values)
+		assert.Equal(t, []error{nil, nil, nil, nil}, errs)
+		assert.Equal(t, [][]int{{1, 2, 3}}, batches)
+
+		// A later load starts a new batch.
+		value, err := loader.Load(context.Background(), 4)
+		require.NoError(t, err)
+		assert.Equal(t, "4", value)
+		assert.Equal(t, [][]int{{1, 2, 3}, {4}}, batches)
+	})
+
+	t.Run("MaxBatch", func(t *testing.T) {
+		batches = nil
+		// With a long wait, the batches are dispatched only when full.
+		loader := NewBatchLoader(fetch, time.Hour, 2)
+		values, errs := loadAll(loader, []int{1, 2, 3, 4})
+		assert.Equal(t, []string{"1", "2", "3", "4"}, values)
+		assert.Equal(t, []error{nil, nil, nil, nil}, errs)
+		assert.Len(t, batches, 2)
+	})
+
+	t.Run("MissingKey", func(t *testing.T) {
+		loader := NewBatchLoader(fetch, time.Millisecond, 0)
+		_, err := loader.Load(context.Background(), -1)
+		assert.EqualError(t, err, "batch loader: no value for key -1")
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		errFetch := errors.New("fetch failed")
+		loader := NewBatchLoader(func(ctx context.Context, keys []int) (map[int]string, error) {
+			return nil, errFetch
+		}, time.Millisecond, 0)
+		_, errs := loadAll(loader, []int{1, 2})
+		assert.Equal(t, []error{errFetch, errFetch}, errs)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		loader := NewBatchLoader(fetch, time.Hour, 0)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := loader.Load(ctx, 1)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("FirstCallerCanceled", func(t *testing.T) {
+		type ctxKey struct{}
+		var gotValue interface{}
+		var gotErr error
+		loader := NewBatchLoader(func(ctx context.Context, keys []int) (map[int]string, error) {
+			gotValue = ctx.Value(ctxKey{})
+			gotErr = ctx.Err()
+			return fetch(ctx, keys)
+		}, 50*time.Millisecond, 0)
+
+		ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "v"))
+		firstErr := make(chan error)
+		go func() {
+			_, err := loader.Load(ctx, 1)
+			firstErr <- err
+		}()
+		// Once the first caller has started the batch, it gives up...
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+		assert.ErrorIs(t, <-firstErr, context.Canceled)
+
+		// ... but the others in the batch still get their values.
+		values, errs := loadAll(loader, []int{2, 3})
+		assert.Equal(t, []string{"2", "3"}, values)
+		assert.Equal(t, []error{nil, nil}, errs)
+		assert.NoError(t, gotErr)
+		assert.Equal(t, "v", gotValue)
+	})
+}
@@ -0,0 +1,109 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// cachingClient is the [Client] returned by [NewCachingClient].
+type cachingClient struct {
+	inner Client
+	now   func() time.Time // for tests
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+// cacheEntry is a single cached response.
+type cacheEntry struct {
+	expires    time.Time
+	data       json.RawMessage
+	extensions map[string]interface{}
+}
+
+// NewCachingClient returns a [Client] which caches the responses to queries
+// made through it in memory, for the time given by each request's
+// [Request.CacheTTL].  genqlient sets the TTL for queries with
+// @genqlient(cacheTTL: ...), for example
+//
+//	# @genqlient(cacheTTL: "60s")
+//	query GetCountries { countries { code name } }
+//
+// Requests with no TTL are always made with inner, as are those which
+// [NewSingleflightClient] would not coalesce: mutations, requests with file
+// uploads or a VariablesFunc, requests with per-request headers or
+// [WithRawResponse], and those with a custom response type (see
+// [Response.Envelope]).  Requests are cached by their query, operation name,
+// variables, and endpoint.  Only successful responses, with no errors, are
+// cached; each caller gets its own copy of the response data.
+//
+// Expired responses are removed as new ones are cached, so the cache holds
+// roughly the responses to queries made within their TTL.
+func NewCachingClient(inner Client) Client {
+	return &cachingClient{inner: inner, now: time.Now, entries: map[string]*cacheEntry{}}
+}
+
+func (c *cachingClient) Close() error {
+	return CloseClient(c.inner)
+}
+
+func (c *cachingClient) Shutdown(ctx context.Context) error {
+	return ShutdownClient(ctx, c.inner)
+}
+
+func (c *cachingClient) MakeRequest(ctx context.Context, req *Request, resp *Response) error {
+	if req.CacheTTL <= 0 {
+		return c.inner.MakeRequest(ctx, req, resp)
+	}
+	key, ok := singleflightKey(ctx, req, resp)
+	if !ok {
+		return c.inner.MakeRequest(ctx, req, resp)
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && c.now().Before(entry.expires) {
+		if resp == nil {
+			return nil
+		}
+		resp.Extensions = entry.extensions
+		if resp.Data != nil {
+			return json.Unmarshal(entry.data, resp.Data)
+		}
+		return nil
+	}
+
+	var data json.RawMessage
+	innerResp := &Response{Data: &data}
+	err := c.inner.MakeRequest(ctx, req, innerResp)
+	if resp != nil {
+		resp.Extensions = innerResp.Extensions
+		resp.Errors = innerResp.Errors
+		if len(data) > 0 && resp.Data != nil {
+			if unmarshalErr := json.Unmarshal(data, resp.Data); unmarshalErr != nil && err == nil {
+				err = unmarshalErr
+			}
+		}
+	}
+	if err != nil || len(innerResp.Errors) > 0 || len(data) == 0 {
+		return err
+	}
+
+	now := c.now()
+	c.mu.Lock()
+	for k, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = &cacheEntry{
+		expires:    now.Add(req.CacheTTL),
+		data:       data,
+		extensions: innerResp.Extensions,
+	}
+	c.mu.Unlock()
+	return nil
+}
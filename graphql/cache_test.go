@@ -0,0 +1,68 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachingClient(t *testing.T) {
+	inner := &blockingClient{release: make(chan struct{})}
+	close(inner.release)
+	client := NewCachingClient(inner).(*cachingClient)
+	now := time.Now()
+	client.now = func() time.Time { return now }
+
+	get := func(req *Request) int {
+		var data countData
+		require.NoError(t, client.MakeRequest(context.Background(), req, &Response{Data: &data}))
+		return data.N
+	}
+
+	cached := &Request{Query: "query q { n }", OpName: "q", CacheTTL: time.Minute}
+	other := &Request{Query: "query q { n }", OpName: "q", Variables: map[string]int{"x": 1}, CacheTTL: time.Minute}
+	uncached := &Request{Query: "query q { n }", OpName: "q"}
+	mutation := &Request{Query: "mutation m { n }", OpName: "m", CacheTTL: time.Minute}
+
+	assert.Equal(t, 1, get(cached))
+	assert.Equal(t, 1, get(cached))
+	assert.Equal(t, 2, get(other))
+	assert.Equal(t, 3, get(uncached))
+	assert.Equal(t, 4, get(uncached))
+	assert.Equal(t, 5, get(mutation))
+	assert.Equal(t, 6, get(mutation))
+
+	now = now.Add(30 * time.Second)
+	assert.Equal(t, 1, get(cached))
+
+	now = now.Add(30 * time.Second)
+	assert.Equal(t, 7, get(cached))
+	assert.Equal(t, 7, get(cached))
+	assert.Len(t, client.entries, 1) // the expired entry for other was removed
+
+	// Errors aren't cached.
+	inner.err = errors.New("oops")
+	errored := &Request{Query: "query e { n }", OpName: "e", CacheTTL: time.Minute}
+	var data countData
+	err := client.MakeRequest(context.Background(), errored, &Response{Data: &data})
+	assert.Error(t, err)
+	assert.Equal(t, 8, data.N)
+	inner.err = nil
+	assert.Equal(t, 9, get(errored))
+	assert.Equal(t, 9, get(errored))
+}
+
+func TestCachingClientEnvelope(t *testing.T) {
+	client := NewCachingClient(&envelopeClient{})
+	req := &Request{Query: "query q { n }", OpName: "q", CacheTTL: time.Minute}
+	for i := 1; i <= 2; i++ {
+		// The request isn't cached, so that each envelope is filled.
+		resp := makeEnvelopeRequest(t, client, req)
+		assert.Equal(t, 7, resp.Cost)
+		assert.Equal(t, i, resp.Data.(*countData).N)
+	}
+}
@@ -13,10 +13,14 @@ import (
 	"net/textproto"
 	"net/url"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
 )
 
 // Client is the interface that the generated code calls into to actually make
@@ -45,10 +49,59 @@ type Client interface {
 	) error
 }
 
+// Closer is implemented by [Client] implementations which hold resources,
+// such as pending batches or open connections, that should be released when
+// the client is no longer needed.
+//
+// Generated code never calls Close; it's up to the owner of the client to do
+// so, typically via [CloseClient].
+type Closer interface {
+	Client
+	// Close flushes any pending work (e.g. requests waiting to be batched)
+	// and releases the client's resources.  The client may not be used after
+	// Close has been called.
+	Close() error
+}
+
+// CloseClient closes the given client, if it implements [Closer], and is a
+// no-op otherwise.
+//
+// This is useful for code which accepts an arbitrary [Client], or for
+// wrapper clients which should close the client they wrap.
+func CloseClient(c Client) error {
+	if closer, ok := c.(Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
 type client struct {
 	httpClient Doer
 	endpoint   string
 	method     string
+	metrics    MetricsHook
+
+	decompressors     map[string]DecompressFunc
+	requestModifiers  []func(*Request)
+	omitOperationName bool
+	fieldNames        requestFieldNames
+	signer            RequestSigner
+	classifyErrors    func(gqlerror.List) error
+	wrapError         func(opName string, err error) error
+	unwrapResponse    func([]byte) ([]byte, error)
+	resolveEndpoint   func(context.Context, *Request) (string, error)
+	bearerToken       func() string
+	traceparent       func(context.Context) string
+	maxComplexity     int
+	disallowUnknown   bool
+	etags             *etagCache
+	requestIDHeader   string
+	formEncoded       bool
+	inFlight          inFlightTracker
+
+	resumableUploadThreshold int64
+	resumableUpload          ResumableUploadFunc
+	extraFormFields          []formField
 }
 
 // NewClient returns a [Client] which makes requests to the given endpoint,
@@ -60,11 +113,14 @@ type client struct {
 //
 // The typical method of adding authentication headers is to wrap the client's
 // [http.Transport] to add those headers.  See [example/main.go] for an
-// example.
+// example.  For cookie-based sessions, set the [http.Client.Jar]; it applies
+// to all requests the client makes, including file uploads.
+//
+// The client may be further configured by passing [ClientOption] values.
 //
 // [example/main.go]: https://github.com/Khan/genqlient/blob/main/example/main.go#L12-L20
-func NewClient(endpoint string, httpClient Doer) Client {
-	return newClient(endpoint, httpClient, http.MethodPost)
+func NewClient(endpoint string, httpClient Doer, opts ...ClientOption) Client {
+	return newClient(endpoint, httpClient, http.MethodPost, opts)
 }
 
 // NewClientUsingGet returns a [Client] which makes GET requests to the given
@@ -76,23 +132,55 @@ func NewClient(endpoint string, httpClient Doer) Client {
 // parameters.  It will use the given [http.Client], or [http.DefaultClient] if
 // a nil client is passed.
 //
-// The client does not support mutations, and will return an error if passed a
-// request that attempts one.
+// The client does not support mutations or file uploads (except those sent
+// out-of-band; see [WithResumableUploads]), and will return an error if
+// passed a request that attempts one.
 //
 // The typical method of adding authentication headers is to wrap the client's
 // [http.Transport] to add those headers.  See [example/main.go] for an
 // example.
 //
+// The client may be further configured by passing [ClientOption] values.
+//
 // [example/main.go]: https://github.com/Khan/genqlient/blob/main/example/main.go#L12-L20
-func NewClientUsingGet(endpoint string, httpClient Doer) Client {
-	return newClient(endpoint, httpClient, http.MethodGet)
+func NewClientUsingGet(endpoint string, httpClient Doer, opts ...ClientOption) Client {
+	return newClient(endpoint, httpClient, http.MethodGet, opts)
 }
 
-func newClient(endpoint string, httpClient Doer, method string) Client {
+// NewClientUsingFormPost returns a [Client] which makes POST requests to the
+// given endpoint with a form-encoded body, for older servers which don't
+// accept JSON.
+//
+// The body has the same parameters as the URL of a request from
+// [NewClientUsingGet]: query, operationName, and variables (as JSON), with
+// Content-Type application/x-www-form-urlencoded.  It will use the given
+// [http.Client], or [http.DefaultClient] if a nil client is passed.
+//
+// The client does not support file uploads (except those sent out-of-band;
+// see [WithResumableUploads]), and will return an error if passed a request
+// that attempts one.
+//
+// The client may be further configured by passing [ClientOption] values.
+func NewClientUsingFormPost(endpoint string, httpClient Doer, opts ...ClientOption) Client {
+	c := newClient(endpoint, httpClient, http.MethodPost, opts)
+	c.formEncoded = true
+	return c
+}
+
+func newClient(endpoint string, httpClient Doer, method string, opts []ClientOption) *client {
 	if httpClient == nil || httpClient == (*http.Client)(nil) {
 		httpClient = http.DefaultClient
 	}
-	return &client{httpClient, endpoint, method}
+	c := &client{
+		httpClient: httpClient,
+		endpoint:   endpoint,
+		method:     method,
+		fieldNames: defaultRequestFieldNames,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // Doer encapsulates the methods from [*http.Client] needed by [Client].
@@ -118,10 +206,157 @@ type Request struct {
 	// A JSON-marshalable value containing the variables to be sent
 	// along with the query, or nil if there are none.
 	Variables interface{} `json:"variables,omitempty"`
+	// If set, a function which computes the variables when the request is
+	// sent, overriding Variables.  This is useful for values which must be
+	// fresh, like timestamps or nonces.  It's called each time the request
+	// is passed to MakeRequest, so if you retry a request it will be called
+	// again.  (To set it on requests from generated code, use
+	// [WithRequestModifier]; modifiers run first.)
+	VariablesFunc func(ctx context.Context) (interface{}, error) `json:"-"`
 	// The GraphQL operation name. The server typically doesn't
 	// require this unless there are multiple queries in the
 	// document, but genqlient sets it unconditionally anyway.
+	// (To omit it, see [WithoutOperationName].)
 	OpName string `json:"operationName"`
+	// The complexity of the operation, if known, used by
+	// [WithMaxComplexity].  genqlient sets it if the generate_complexity
+	// option is enabled.  It's not sent to the server.
+	Complexity int `json:"-"`
+	// Whether the operation is a mutation, used by [NewRetryingClient] to
+	// decide whether the request may safely be retried.  genqlient sets it
+	// for mutations.  It's not sent to the server.
+	IsMutation bool `json:"-"`
+	// How long the response may be cached, if at all, used by
+	// [NewCachingClient].  genqlient sets it for queries with
+	// @genqlient(cacheTTL: ...).  It's not sent to the server.
+	CacheTTL time.Duration `json:"-"`
+}
+
+// Vars is a set of GraphQL variables, by name, for operations which aren't
+// generated by genqlient and so have no variables type.  Its values must be
+// JSON-marshalable; they may include [Upload] values, even within nested
+// maps, slices, and structs.
+type Vars map[string]interface{}
+
+// NewRequest returns a [Request] for the given operation, with the given
+// variables (which may be nil), for dynamic operations which can't be
+// generated ahead of time.  Make the request with [Client.MakeRequest]:
+//
+//	req := graphql.NewRequest(`query GetUser($id: ID!) { user(id: $id) { name } }`,
+//		"GetUser", graphql.Vars{"id": id})
+//	var data struct{ User struct{ Name string } }
+//	err := client.MakeRequest(ctx, req, &graphql.Response{Data: &data})
+func NewRequest(query, opName string, vars Vars) *Request {
+	req := &Request{Query: query, OpName: opName}
+	if vars != nil {
+		// (Avoid setting Variables to a typed nil, which isn't nil.)
+		req.Variables = vars
+	}
+	return req
+}
+
+// OperationName returns the name of the first operation in the given
+// GraphQL document, for example "GetUser" for
+//
+//	query GetUser($id: ID!) { user(id: $id) { name } }
+//
+// It returns the empty string if the first operation is anonymous, or if the
+// document can't be parsed.  This is useful in middleware which handles
+// requests whose OpName may not be set, such as those from [NewRequest].
+func OperationName(query string) string {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil || len(doc.Operations) == 0 {
+		return ""
+	}
+	return doc.Operations[0].Name
+}
+
+// String returns a human-readable representation of the request, with its
+// operation name, query, and (indented) variables, for use in logging and
+// debugging.  Uploads are shown by file name; their contents are omitted.
+func (req *Request) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "operation: %s\n", req.OpName)
+	fmt.Fprintf(&b, "query: %s\n", strings.TrimSpace(req.Query))
+	if req.Variables == nil {
+		b.WriteString("variables: (none)")
+		return b.String()
+	}
+
+	variables, err := prettyVariables(req.Variables)
+	if err != nil {
+		fmt.Fprintf(&b, "variables: (unable to marshal: %v)", err)
+	} else {
+		fmt.Fprintf(&b, "variables: %s", variables)
+	}
+	return b.String()
+}
+
+// prettyVariables returns the variables as indented JSON, with each Upload
+// replaced by a placeholder.
+func prettyVariables(variables interface{}) (string, error) {
+	value, err := decodeVariables(variables)
+	if err != nil {
+		return "", err
+	}
+
+	// If we can't find the files (e.g. one has a nil body), we have nothing
+	// to hide anyway.
+	files, _ := findVariablesFiles("", variables)
+	for _, file := range files {
+		path := strings.Split(strings.TrimPrefix(file.mapKey, "."), ".")
+		value = replaceAtPath(value, path,
+			fmt.Sprintf("<upload %s>", file.file.FileName))
+	}
+
+	var out strings.Builder
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(value)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(out.String(), "\n"), nil
+}
+
+// decodeVariables returns the variables as an unmarshaled JSON value, which
+// (unlike the generated variables type) may be modified with replaceAtPath.
+func decodeVariables(variables interface{}) (interface{}, error) {
+	body, err := json.Marshal(variables)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	err = decoder.Decode(&value)
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// replaceAtPath replaces the value at the given path (of object keys and list
+// indices, as computed by findFiles) within the unmarshaled JSON value.
+func replaceAtPath(value interface{}, path []string, replacement interface{}) interface{} {
+	if len(path) == 0 {
+		return replacement
+	}
+	// findFiles uses the whole JSON tag, which may include options.
+	key := strings.Split(path[0], ",")[0]
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if elem, ok := value[key]; ok {
+			value[key] = replaceAtPath(elem, path[1:], replacement)
+		}
+	case []interface{}:
+		i, err := strconv.Atoi(key)
+		if err == nil && i >= 0 && i < len(value) {
+			value[i] = replaceAtPath(value[i], path[1:], replacement)
+		}
+	}
+	return value
 }
 
 // Response that contains data returned by the GraphQL API.
@@ -137,32 +372,212 @@ type Response struct {
 	Data       interface{}            `json:"data"`
 	Extensions map[string]interface{} `json:"extensions,omitempty"`
 	Errors     gqlerror.List          `json:"errors,omitempty"`
+
+	// If set, a pointer to a struct which embeds this Response, and which may
+	// have additional fields for other top-level keys of the response
+	// payload.  The client returned by [NewClient] decodes the payload into
+	// Envelope instead, so as to populate those fields too.  genqlient sets
+	// this if you configure a custom response type with the response_type
+	// option in genqlient.yaml.
+	Envelope interface{} `json:"-"`
 }
 
 func (c *client) MakeRequest(ctx context.Context, req *Request, resp *Response) error {
+	err := c.makeRequestWithID(ctx, req, resp)
+	if err != nil && c.wrapError != nil {
+		if wrapped := c.wrapError(req.OpName, err); wrapped != nil {
+			return wrapped
+		}
+	}
+	return err
+}
+
+func (c *client) makeRequestWithID(ctx context.Context, req *Request, resp *Response) error {
+	if c.requestIDHeader == "" {
+		return c.makeTrackedRequest(ctx, req, resp)
+	}
+
+	ctx, id, err := ensureRequestID(ctx)
+	if err != nil {
+		return err
+	}
+	err = c.makeTrackedRequest(ctx, req, resp)
+	if err != nil {
+		return &RequestIDError{RequestID: id, Err: err}
+	}
+	return nil
+}
+
+// makeTrackedRequest makes the request, tracking it as in flight so that
+// Shutdown can wait for it, or returns ErrClientShutdown if the client has
+// been shut down.
+func (c *client) makeTrackedRequest(ctx context.Context, req *Request, resp *Response) error {
+	if !c.inFlight.start() {
+		return ErrClientShutdown
+	}
+	defer c.inFlight.finish()
+	return c.makeRequestWithMetrics(ctx, req, resp)
+}
+
+func (c *client) makeRequestWithMetrics(ctx context.Context, req *Request, resp *Response) error {
+	if c.metrics == nil {
+		return c.makeRequest(ctx, req, resp)
+	}
+
+	c.metrics.RequestStarted(ctx, req.OpName)
+	start := time.Now()
+	err := c.makeRequest(ctx, req, resp)
+	c.metrics.RequestFinished(ctx, req.OpName, time.Since(start), err)
+	return err
+}
+
+func (c *client) makeRequest(ctx context.Context, req *Request, resp *Response) error {
+	opts := optionsFromContext(ctx)
+	var cancel context.CancelFunc
+	if ctx != nil && opts.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, opts.timeout)
+		defer func() {
+			if cancel != nil { // (nil if we handed it off to a raw response)
+				cancel()
+			}
+		}()
+	}
+
+	if len(c.requestModifiers) > 0 {
+		// Copy, so as not to modify the caller's request (which a retrying
+		// client, say, would then modify again).
+		reqCopy := *req
+		req = &reqCopy
+		for _, modify := range c.requestModifiers {
+			modify(req)
+		}
+	}
+
+	if c.maxComplexity > 0 && req.Complexity > c.maxComplexity {
+		return fmt.Errorf("%w: %s has complexity %d, but the maximum is %d",
+			ErrComplexityExceeded, req.OpName, req.Complexity, c.maxComplexity)
+	}
+
+	if req.VariablesFunc != nil {
+		varsCtx := ctx
+		if varsCtx == nil {
+			varsCtx = context.Background()
+		}
+		variables, err := req.VariablesFunc(varsCtx)
+		if err != nil {
+			return fmt.Errorf("computing variables: %w", err)
+		}
+		// Copy, so that each call evaluates VariablesFunc afresh.
+		reqCopy := *req
+		reqCopy.Variables = variables
+		reqCopy.VariablesFunc = nil
+		req = &reqCopy
+	}
+
+	if c.omitOperationName && req.OpName != "" {
+		// Copy, so as not to modify the caller's request.  (This suffices
+		// for GET and form requests, which omit an empty operation name;
+		// marshalRequest omits it from JSON bodies.)
+		reqCopy := *req
+		reqCopy.OpName = ""
+		req = &reqCopy
+	}
+
 	var httpReq *http.Request
 	var err error
 	var fileVariables []*fileVariable
 	if req.Variables != nil {
-		fileVariables, err = findFiles("variables", reflect.ValueOf(req.Variables), 0)
+		fileVariables, err = findVariablesFiles("variables", req.Variables)
 	}
 	if err != nil {
 		return fmt.Errorf("error finding file variables: %w", err)
 	}
+	if len(fileVariables) > 0 && c.resumableUpload != nil {
+		req, fileVariables, err = c.uploadResumably(ctx, req, fileVariables)
+		if err != nil {
+			return err
+		}
+	}
+	if len(fileVariables) > 0 && c.method == http.MethodGet {
+		// (Files can only be sent in a multipart POST body; sending them as
+		// JSON in the URL would silently send empty objects.)
+		return errors.New("file uploads are not supported over GET; use a client from NewClient instead")
+	}
+	if len(fileVariables) > 0 && c.formEncoded {
+		return errors.New("file uploads are not supported with form-encoded requests; use a client from NewClient instead")
+	}
 
-	if c.method == http.MethodGet {
-		httpReq, err = c.createGetRequest(req)
-	} else {
-		httpReq, err = c.createPostRequest(req, fileVariables)
+	endpoint := c.endpoint
+	if opts.endpoint != "" {
+		endpoint = opts.endpoint
+	}
+	if c.resolveEndpoint != nil {
+		resolveCtx := ctx
+		if resolveCtx == nil {
+			resolveCtx = context.Background()
+		}
+		resolved, err := c.resolveEndpoint(resolveCtx, req)
+		if err != nil {
+			return fmt.Errorf("resolving endpoint: %w", err)
+		}
+		if resolved != "" {
+			endpoint = resolved
+		}
+	}
+
+	switch {
+	case c.method == http.MethodGet:
+		httpReq, err = c.createGetRequest(req, endpoint)
+	case c.formEncoded:
+		httpReq, err = c.createFormPostRequest(req, endpoint)
+	default:
+		httpReq, err = c.createPostRequest(req, endpoint, fileVariables)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if len(fileVariables) == 0 || c.method == http.MethodGet {
+	if c.formEncoded {
+		httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else if len(fileVariables) == 0 {
 		httpReq.Header.Set("Content-Type", "application/json")
 	}
+	if c.decompressors != nil {
+		httpReq.Header.Set("Accept-Encoding", acceptEncoding(c.decompressors))
+	}
+	if c.signer != nil {
+		err = signRequest(httpReq, c.signer)
+		if err != nil {
+			return err
+		}
+	}
+	if c.bearerToken != nil {
+		if token := c.bearerToken(); token != "" {
+			httpReq.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+	if c.requestIDHeader != "" {
+		httpReq.Header.Set(c.requestIDHeader, RequestIDFromContext(ctx))
+	}
+	if c.traceparent != nil {
+		traceCtx := ctx
+		if traceCtx == nil {
+			traceCtx = context.Background()
+		}
+		if traceparent := c.traceparent(traceCtx); traceparent != "" {
+			httpReq.Header.Set("Traceparent", traceparent)
+		}
+	}
+	for key, values := range opts.header {
+		httpReq.Header[key] = values
+	}
+
+	var etagKey string
+	if c.etags != nil && c.method == http.MethodGet && opts.rawResponse == nil {
+		etagKey = httpReq.URL.String()
+		c.etags.setIfNoneMatch(etagKey, httpReq)
+	}
 
 	if ctx != nil {
 		httpReq = httpReq.WithContext(ctx)
@@ -172,39 +587,172 @@ func (c *client) MakeRequest(ctx context.Context, req *Request, resp *Response)
 	if err != nil {
 		return err
 	}
-	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != http.StatusOK {
+	decompressed, err := decompressBody(httpResp, c.decompressors)
+	if err != nil {
+		httpResp.Body.Close()
+		return err
+	}
+
+	if opts.rawResponse != nil && httpResp.StatusCode == http.StatusOK {
+		// The caller now owns the body, and the timeout (if any).
+		*opts.rawResponse = &rawResponseBody{Reader: decompressed, body: decompressed, cancel: cancel}
+		cancel = nil
+		return nil
+	}
+	defer decompressed.Close()
+	var body io.Reader = decompressed
+
+	statusCode := httpResp.StatusCode
+	if etagKey != "" {
+		body, statusCode, err = c.etags.handleResponse(etagKey, httpResp, body)
+		if err != nil {
+			return err
+		}
+	}
+
+	if statusCode != http.StatusOK {
 		var respBody []byte
-		respBody, err = io.ReadAll(httpResp.Body)
+		respBody, err = io.ReadAll(body)
 		if err != nil {
 			respBody = []byte(fmt.Sprintf("<unreadable: %v>", err))
 		}
-		return fmt.Errorf("returned error %v: %s", httpResp.Status, respBody)
+		return &HTTPError{StatusCode: statusCode, Status: httpResp.Status, Body: respBody}
+	}
+
+	if c.unwrapResponse != nil {
+		var respBody []byte
+		respBody, err = io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		respBody, err = c.unwrapResponse(respBody)
+		if err != nil {
+			return fmt.Errorf("error unwrapping response: %w", err)
+		}
+		body = bytes.NewReader(respBody)
 	}
 
-	err = json.NewDecoder(httpResp.Body).Decode(resp)
+	// Note whether the response has any data, so we can tell partial data
+	// from none at all.  (If the caller didn't prepopulate resp.Data with a
+	// pointer, json will set it to a new value just if the response has
+	// data.)
+	data := resp.Data
+	presence := &dataPresence{data: data, disallowUnknownFields: c.disallowUnknown, req: req}
+	if data != nil && reflect.ValueOf(data).Kind() == reflect.Ptr {
+		resp.Data = presence
+	}
+	var target interface{} = resp
+	if resp.Envelope != nil {
+		target = resp.Envelope
+	}
+	size := int64(-1) // unknown
+	if reader, ok := body.(*bytes.Reader); ok {
+		size = int64(reader.Len())
+	} else if body == io.Reader(httpResp.Body) {
+		size = httpResp.ContentLength
+	}
+	err = decodeResponse(body, size, target)
+	hasData := resp.Data != nil
+	if resp.Data == presence {
+		// (If data was null, json will already have set resp.Data to nil.)
+		resp.Data = data
+		hasData = presence.present
+	}
 	if err != nil {
 		return err
 	}
 	if len(resp.Errors) > 0 {
-		return resp.Errors
+		err = resp.Errors
+		if !hasData {
+			err = &noDataError{errors: resp.Errors}
+		}
+		if c.classifyErrors != nil {
+			err = classifyErrors(resp.Errors, err, c.classifyErrors)
+		}
+		return err
 	}
 	return nil
 }
 
-func (c *client) createPostRequest(req *Request, fileVariables []*fileVariable) (*http.Request, error) {
+// smallResponseSize is the size, in bytes, up to which decodeResponse reads
+// a response in full and unmarshals it, rather than streaming it through a
+// json.Decoder, which is about 20% faster for small responses (see
+// BenchmarkDecodeResponse).  We stream larger ones, so as not to allocate a
+// buffer of whatever size the server claims.
+const smallResponseSize = 64 << 10
+
+// decodeResponse decodes the JSON response body, of the given size (or -1
+// if unknown), into target.
+func decodeResponse(body io.Reader, size int64, target interface{}) error {
+	if size < 0 || size > smallResponseSize {
+		return json.NewDecoder(body).Decode(target)
+	}
+	return unmarshalResponse(body, size, target)
+}
+
+// unmarshalResponse reads the JSON response body, of the given size, in
+// full, and unmarshals it into target.
+func unmarshalResponse(body io.Reader, size int64, target interface{}) error {
+	b := make([]byte, size)
+	_, err := io.ReadFull(body, b)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, target)
+}
+
+// dataPresence wraps Response.Data while the response is decoded, and
+// records whether the response had (non-null) data.  It's also where we
+// apply WithDisallowUnknownFields, since that should apply only to the data,
+// not to the errors or extensions, and where builds with the
+// genqlient_debug tag check the data against req's selection.
+type dataPresence struct {
+	data                  interface{}
+	present               bool
+	disallowUnknownFields bool
+	req                   *Request
+}
+
+func (d *dataPresence) UnmarshalJSON(b []byte) error {
+	d.present = true
+	checkResponseSelection(d.req, b)
+	if !d.disallowUnknownFields {
+		return json.Unmarshal(b, d.data)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(d.data)
+}
+
+// rawResponseBody is the body handed back by WithRawResponse.  It reads from
+// the (possibly decompressed) Reader, and closing it closes the underlying
+// HTTP response body and releases the request's timeout, if any.
+type rawResponseBody struct {
+	io.Reader
+	body   io.Closer
+	cancel context.CancelFunc
+}
+
+func (b *rawResponseBody) Close() error {
+	if b.cancel != nil {
+		defer b.cancel()
+	}
+	return b.body.Close()
+}
+
+func (c *client) createPostRequest(req *Request, endpoint string, fileVariables []*fileVariable) (*http.Request, error) {
 	if len(fileVariables) > 0 {
-		return createUploadFileRequest(req, c.endpoint, fileVariables)
+		return c.createUploadFileRequest(req, endpoint, fileVariables)
 	}
-	body, err := json.Marshal(req)
+	body, err := c.marshalRequest(req)
 	if err != nil {
 		return nil, err
 	}
 
 	httpReq, err := http.NewRequest(
 		c.method,
-		c.endpoint,
+		endpoint,
 		bytes.NewReader(body))
 	if err != nil {
 		return nil, err
@@ -213,37 +761,21 @@ func (c *client) createPostRequest(req *Request, fileVariables []*fileVariable)
 	return httpReq, nil
 }
 
-func (c *client) createGetRequest(req *Request) (*http.Request, error) {
-	parsedURL, err := url.Parse(c.endpoint)
+func (c *client) createGetRequest(req *Request, endpoint string) (*http.Request, error) {
+	parsedURL, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	queryParams := parsedURL.Query()
-	queryUpdated := false
-
-	if req.Query != "" {
-		if strings.HasPrefix(strings.TrimSpace(req.Query), "mutation") {
-			return nil, errors.New("client does not support mutations")
-		}
-		queryParams.Set("query", req.Query)
-		queryUpdated = true
-	}
-
-	if req.OpName != "" {
-		queryParams.Set("operationName", req.OpName)
-		queryUpdated = true
+	if strings.HasPrefix(strings.TrimSpace(req.Query), "mutation") {
+		return nil, errors.New("client does not support mutations")
 	}
 
-	if req.Variables != nil {
-		variables, variablesErr := json.Marshal(req.Variables)
-		if variablesErr != nil {
-			return nil, variablesErr
-		}
-		queryParams.Set("variables", string(variables))
-		queryUpdated = true
+	queryParams := parsedURL.Query()
+	queryUpdated, err := setRequestValues(queryParams, req, c.fieldNames)
+	if err != nil {
+		return nil, err
 	}
-
 	if queryUpdated {
 		parsedURL.RawQuery = queryParams.Encode()
 	}
@@ -259,6 +791,79 @@ func (c *client) createGetRequest(req *Request) (*http.Request, error) {
 	return httpReq, nil
 }
 
+func (c *client) createFormPostRequest(req *Request, endpoint string) (*http.Request, error) {
+	values := url.Values{}
+	_, err := setRequestValues(values, req, c.fieldNames)
+	if err != nil {
+		return nil, err
+	}
+
+	return http.NewRequest(
+		c.method,
+		endpoint,
+		strings.NewReader(values.Encode()))
+}
+
+// setRequestValues sets the query, operation name, and variables (as JSON)
+// of req in values, under the given names, as sent in the URL by a
+// [NewClientUsingGet] client or in the body by a [NewClientUsingFormPost]
+// client.  It returns whether it set any.
+func setRequestValues(values url.Values, req *Request, names requestFieldNames) (bool, error) {
+	updated := false
+
+	if req.Query != "" {
+		values.Set(names.query, req.Query)
+		updated = true
+	}
+
+	if req.OpName != "" {
+		values.Set(names.operationName, req.OpName)
+		updated = true
+	}
+
+	if req.Variables != nil {
+		variables, err := json.Marshal(req.Variables)
+		if err != nil {
+			return false, err
+		}
+		values.Set(names.variables, string(variables))
+		updated = true
+	}
+
+	return updated, nil
+}
+
+// requestFieldNames are the names under which a request's query, variables,
+// and operation name are sent; see [WithRequestFieldNames].
+type requestFieldNames struct {
+	query, variables, operationName string
+}
+
+var defaultRequestFieldNames = requestFieldNames{
+	query:         "query",
+	variables:     "variables",
+	operationName: "operationName",
+}
+
+// marshalRequest marshals req to JSON, as sent in the body of a POST
+// request, using c's field names.
+func (c *client) marshalRequest(req *Request) ([]byte, error) {
+	if c.fieldNames == defaultRequestFieldNames && !c.omitOperationName {
+		return json.Marshal(req)
+	}
+	// As in the JSON tags of Request, the variables are omitted if empty.
+	// The operation name is omitted just if the client is configured with
+	// WithoutOperationName.
+	body := map[string]interface{}{c.fieldNames.query: req.Query}
+	if req.Variables != nil {
+		body[c.fieldNames.variables] = req.Variables
+	}
+	if !c.omitOperationName {
+		body[c.fieldNames.operationName] = req.OpName
+	}
+	return json.Marshal(body)
+}
+
 type fileVariable struct {
 	mapKey string
 	file   Upload
@@ -271,12 +876,15 @@ func findFiles(parentKey string, v reflect.Value, depth int) ([]*fileVariable, e
 	}
 	fileVariables := []*fileVariable{}
 
-	if v.Kind() == reflect.Ptr {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
 		if v.IsNil() {
 			return fileVariables, nil
 		}
 		v = v.Elem()
 	}
+	if !v.IsValid() {
+		return fileVariables, nil
+	}
 
 	if v.Type() == reflect.TypeOf(Upload{}) {
 		file := v.Interface().(Upload)
@@ -314,23 +922,32 @@ func findFiles(parentKey string, v reflect.Value, depth int) ([]*fileVariable, e
 			}
 			fileVariables = append(fileVariables, files...)
 		}
+	case reflect.Map:
+		// e.g. Vars; we sort the keys so the request is deterministic.
+		if v.Type().Key().Kind() != reflect.String {
+			break
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, key := range keys {
+			files, err := findFiles(parentKey+"."+key.String(), v.MapIndex(key), depth+1)
+			if err != nil {
+				return nil, err
+			}
+			fileVariables = append(fileVariables, files...)
+		}
 	default:
 	}
 
 	return fileVariables, nil
 }
 
-func createUploadFileRequest(req *Request, endpoint string, fileVariables []*fileVariable) (*http.Request, error) {
-	httpRequest, err := http.NewRequest(http.MethodPost, endpoint, http.NoBody)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
-	}
+func (c *client) createUploadFileRequest(req *Request, endpoint string, fileVariables []*fileVariable) (*http.Request, error) {
 	bodyBuf := &bytes.Buffer{}
 	bodyWriter := multipart.NewWriter(bodyBuf)
-	defer bodyWriter.Close()
 
 	// operations
-	requestBody, err := json.Marshal(req)
+	requestBody, err := c.marshalRequest(req)
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling request: %w", err)
 	}
@@ -344,7 +961,10 @@ func createUploadFileRequest(req *Request, endpoint string, fileVariables []*fil
 	variablesString := []string{}
 	if len(fileVariables) > 0 {
 		for i, files := range fileVariables {
-			variablesString = append(variablesString, fmt.Sprintf("\"%d\":[\"%s\"]", i, files.mapKey))
+			// The keys are found under "variables"; they're sent under the
+			// client's name for the variables.
+			mapKey := c.fieldNames.variables + strings.TrimPrefix(files.mapKey, "variables")
+			variablesString = append(variablesString, fmt.Sprintf("\"%d\":[\"%s\"]", i, mapKey))
 		}
 	}
 	mapData = `{` + strings.Join(variablesString, ",") + `}`
@@ -353,6 +973,14 @@ func createUploadFileRequest(req *Request, endpoint string, fileVariables []*fil
 		return nil, fmt.Errorf("error writing map data to body: %w", err)
 	}
 
+	// extra fields
+	for _, field := range c.extraFormFields {
+		err = bodyWriter.WriteField(field.name, field.value)
+		if err != nil {
+			return nil, fmt.Errorf("error writing field %s to body: %w", field.name, err)
+		}
+	}
+
 	// files
 	for i, fileVariable := range fileVariables {
 		header := make(textproto.MIMEHeader)
@@ -376,7 +1004,21 @@ func createUploadFileRequest(req *Request, endpoint string, fileVariables []*fil
 			return nil, fmt.Errorf("error writing file to body: %w", err)
 		}
 	}
-	httpRequest.Body = io.NopCloser(bodyBuf)
+	// We must close the writer (which writes the final boundary) before
+	// building the request, so that the request knows its full length.
+	err = bodyWriter.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error closing multipart body: %w", err)
+	}
+
+	// Passing the buffer to http.NewRequest (rather than setting Body
+	// directly) means the request gets a ContentLength and GetBody, so it
+	// behaves like any other request when the http.Client follows redirects
+	// or applies its cookie jar.
+	httpRequest, err := http.NewRequest(http.MethodPost, endpoint, bodyBuf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
 	httpRequest.Header.Set("Content-Type", bodyWriter.FormDataContentType())
 
 	return httpRequest, nil
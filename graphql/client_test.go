@@ -0,0 +1,1458 @@
+package graphql
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// newTestServer returns a server which calls handle (if non-nil) on every
+// request, and then, unless handle wrote a body of its own, responds with an
+// empty (but valid) GraphQL response.
+func newTestServer(t *testing.T, handle func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tw := &trackingWriter{ResponseWriter: w}
+		if handle != nil {
+			handle(tw, r)
+		}
+		if tw.wrote {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"data": {}}`))
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// trackingWriter is an http.ResponseWriter which records whether a body has
+// been written to it.
+type trackingWriter struct {
+	http.ResponseWriter
+	wrote bool
+}
+
+func (w *trackingWriter) Write(b []byte) (int, error) {
+	w.wrote = true
+	return w.ResponseWriter.Write(b)
+}
+
+type uploadVariables struct {
+	File Upload `json:"file"`
+}
+
+func TestCookieJar(t *testing.T) {
+	var gotCookies []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("session")
+		if err == nil {
+			gotCookies = append(gotCookies, cookie.Value)
+		} else {
+			gotCookies = append(gotCookies, "")
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc123"})
+	})
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	client := NewClient(server.URL, &http.Client{Jar: jar})
+	ctx := context.Background()
+
+	// The first request sets the cookie...
+	err = client.MakeRequest(ctx,
+		&Request{Query: "query q { f }", OpName: "q"}, &Response{})
+	require.NoError(t, err)
+
+	// ... which is then sent with both ordinary requests and uploads.
+	err = client.MakeRequest(ctx,
+		&Request{Query: "query q { f }", OpName: "q"}, &Response{})
+	require.NoError(t, err)
+
+	err = client.MakeRequest(ctx, &Request{
+		Query:     "mutation m($file: Upload!) { f(file: $file) }",
+		OpName:    "m",
+		Variables: &uploadVariables{File: Upload{FileName: "a.txt", Body: strings.NewReader("hello")}},
+	}, &Response{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"", "abc123", "abc123"}, gotCookies)
+}
+
+func TestUploadRequestLength(t *testing.T) {
+	var gotLength int64
+	var gotFile string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotLength = r.ContentLength
+		file, _, err := r.FormFile("0")
+		if assert.NoError(t, err) {
+			buf := new(strings.Builder)
+			_, err = io.Copy(buf, file)
+			assert.NoError(t, err)
+			gotFile = buf.String()
+		}
+	})
+
+	client := NewClient(server.URL, nil)
+	err := client.MakeRequest(context.Background(), &Request{
+		Query:     "mutation m($file: Upload!) { f(file: $file) }",
+		OpName:    "m",
+		Variables: &uploadVariables{File: Upload{FileName: "a.txt", Body: strings.NewReader("hello")}},
+	}, &Response{})
+	require.NoError(t, err)
+
+	// The multipart body should be complete (and sent with a known length),
+	// so the server can parse it.
+	assert.Greater(t, gotLength, int64(0))
+	assert.Equal(t, "hello", gotFile)
+}
+
+// listedUploadVariables implements UploadLister, as genqlient's generated
+// code does with generate_upload_listers.
+type listedUploadVariables struct {
+	Files []Upload `json:"files"`
+	calls int
+}
+
+func (v *listedUploadVariables) ListUploads(path string, add func(path string, file Upload)) {
+	v.calls++
+	for i, file := range v.Files {
+		add(path+".files."+strconv.Itoa(i), file)
+	}
+}
+
+func TestUploadLister(t *testing.T) {
+	var gotMap, gotFile string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotMap = r.FormValue("map")
+		file, _, err := r.FormFile("1")
+		if assert.NoError(t, err) {
+			buf := new(strings.Builder)
+			_, err = io.Copy(buf, file)
+			assert.NoError(t, err)
+			gotFile = buf.String()
+		}
+	})
+
+	variables := &listedUploadVariables{Files: []Upload{
+		{FileName: "a.txt", Body: strings.NewReader("hello")},
+		{FileName: "b.txt", Body: strings.NewReader("world")},
+	}}
+	err := NewClient(server.URL, nil).MakeRequest(context.Background(), &Request{
+		Query:     "mutation m($files: [Upload!]!) { f(files: $files) }",
+		OpName:    "m",
+		Variables: variables,
+	}, &Response{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, variables.calls)
+	assert.JSONEq(t, `{"0": ["variables.files.0"], "1": ["variables.files.1"]}`, gotMap)
+	assert.Equal(t, "world", gotFile)
+}
+
+func TestRequestString(t *testing.T) {
+	type input struct {
+		Name  string   `json:"name"`
+		Files []Upload `json:"files"`
+	}
+	req := &Request{
+		OpName: "uploadFiles",
+		Query:  "\nmutation uploadFiles($name: String!, $files: [Upload!]!) { upload }\n",
+		Variables: &input{
+			Name: "<b>",
+			Files: []Upload{
+				{FileName: "a.txt", Body: strings.NewReader("secret")},
+				{FileName: "b.txt", Body: strings.NewReader("secret")},
+			},
+		},
+	}
+
+	got := req.String()
+	assert.Equal(t, `operation: uploadFiles
+query: mutation uploadFiles($name: String!, $files: [Upload!]!) { upload }
+variables: {
+  "files": [
+    "<upload a.txt>",
+    "<upload b.txt>"
+  ],
+  "name": "<b>"
+}`, got)
+	assert.NotContains(t, got, "secret")
+
+	req = &Request{OpName: "q", Query: "query q { f }"}
+	assert.Equal(t, "operation: q\nquery: query q { f }\nvariables: (none)", req.String())
+}
+
+func TestOperationName(t *testing.T) {
+	for _, test := range []struct {
+		query string
+		want  string
+	}{
+		{"query GetUser($id: ID!) { user(id: $id) { name } }", "GetUser"},
+		{"mutation CreateUser { createUser { id } }", "CreateUser"},
+		{"fragment F on User { id }\nquery Q { user { ...F } }", "Q"},
+		{"query First { f }\nquery Second { g }", "First"},
+		{"{ user { name } }", ""},
+		{"query { user { name } }", ""},
+		{"query Broken {", ""},
+	} {
+		assert.Equal(t, test.want, OperationName(test.query), test.query)
+	}
+}
+
+func TestNewRequestWithVars(t *testing.T) {
+	var gotOperations, gotMap, gotFile string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotOperations = r.FormValue("operations")
+		gotMap = r.FormValue("map")
+		file, _, err := r.FormFile("0")
+		if assert.NoError(t, err) {
+			buf := new(strings.Builder)
+			_, err = io.Copy(buf, file)
+			assert.NoError(t, err)
+			gotFile = buf.String()
+		}
+	})
+
+	req := NewRequest("mutation m($id: ID!, $input: In!) { f(id: $id, input: $input) }", "m",
+		Vars{
+			"id":    5,
+			"input": map[string]interface{}{"files": []Upload{{FileName: "a.txt", Body: strings.NewReader("hello")}}},
+		})
+	err := NewClient(server.URL, nil).MakeRequest(context.Background(), req, &Response{})
+	require.NoError(t, err)
+	assert.Contains(t, gotOperations, `"id":5`)
+	assert.JSONEq(t, `{"0": ["variables.input.files.0"]}`, gotMap)
+	assert.Equal(t, "hello", gotFile)
+
+	req = NewRequest("query q { f }", "q", nil)
+	assert.Nil(t, req.Variables)
+}
+
+type closingClient struct {
+	Client
+	closed bool
+}
+
+func (c *closingClient) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestCloseClient(t *testing.T) {
+	// Clients that don't need closing are fine to close.
+	require.NoError(t, CloseClient(NewClient("https://example.com/graphql", nil)))
+
+	client := &closingClient{Client: NewClient("https://example.com/graphql", nil)}
+	require.NoError(t, CloseClient(client))
+	assert.True(t, client.closed)
+}
+
+func TestShutdownClient(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+	})
+	client := NewClient(server.URL, nil)
+
+	errs := make(chan error)
+	go func() {
+		var data map[string]interface{}
+		errs <- client.MakeRequest(context.Background(),
+			&Request{Query: "query Q { f }", OpName: "Q"}, &Response{Data: &data})
+	}()
+	<-started
+
+	// Shutdown waits for the in-flight request, or until ctx is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, ShutdownClient(ctx, client), context.DeadlineExceeded)
+
+	// New requests are refused.
+	var data map[string]interface{}
+	err := client.MakeRequest(context.Background(),
+		&Request{Query: "query Q { f }", OpName: "Q"}, &Response{Data: &data})
+	require.ErrorIs(t, err, ErrClientShutdown)
+
+	close(release)
+	require.NoError(t, <-errs)
+	require.NoError(t, ShutdownClient(context.Background(), client))
+
+	// Wrapper clients shut down the client they wrap, and clients which
+	// don't support shutdown are fine to shut down.
+	require.NoError(t, ShutdownClient(context.Background(), NewRetryingClient(client, RetryPolicy{})))
+	require.NoError(t, ShutdownClient(context.Background(), &closingClient{Client: client}))
+}
+
+func TestShutdownClientWithRequestID(t *testing.T) {
+	client := NewClient(newTestServer(t, nil).URL, nil, WithRequestID("X-Request-ID"))
+	require.NoError(t, ShutdownClient(context.Background(), client))
+
+	ctx := ContextWithRequestID(context.Background(), "my-id")
+	err := client.MakeRequest(ctx, &Request{Query: "query Q { f }", OpName: "Q"}, &Response{})
+	require.ErrorIs(t, err, ErrClientShutdown)
+	var idErr *RequestIDError
+	require.ErrorAs(t, err, &idErr)
+	assert.Equal(t, "my-id", idErr.RequestID)
+}
+
+func TestContextWithOptions(t *testing.T) {
+	var gotHeaders []http.Header
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = append(gotHeaders, r.Header)
+	})
+	client := NewClient(server.URL, nil)
+
+	parent := ContextWithOptions(context.Background(),
+		WithHeader("X-Parent", "parent"), WithHeader("X-Overridden", "parent"))
+	ctx := ContextWithOptions(parent,
+		WithHeader("X-Overridden", "child"), WithHeader("X-Child", "child"))
+
+	for _, ctx := range []context.Context{ctx, parent} {
+		err := client.MakeRequest(ctx,
+			&Request{Query: "query q { f }", OpName: "q"}, &Response{})
+		require.NoError(t, err)
+	}
+
+	require.Len(t, gotHeaders, 2)
+	assert.Equal(t, "parent", gotHeaders[0].Get("X-Parent"))
+	assert.Equal(t, "child", gotHeaders[0].Get("X-Overridden"))
+	assert.Equal(t, "child", gotHeaders[0].Get("X-Child"))
+	assert.Equal(t, "application/json", gotHeaders[0].Get("Content-Type"))
+
+	assert.Equal(t, "parent", gotHeaders[1].Get("X-Parent"))
+	assert.Equal(t, "parent", gotHeaders[1].Get("X-Overridden"))
+	assert.Equal(t, "", gotHeaders[1].Get("X-Child"))
+}
+
+func TestWithTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	t.Cleanup(func() { close(release) }) // (runs before server.Close)
+	client := NewClient(server.URL, nil)
+
+	ctx := ContextWithOptions(context.Background(), WithTimeout(10*time.Millisecond))
+	err := client.MakeRequest(ctx,
+		&Request{Query: "query q { f }", OpName: "q"}, &Response{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+type recordingMetricsHook struct {
+	started  []string
+	finished []string
+	errs     []error
+}
+
+func (h *recordingMetricsHook) RequestStarted(ctx context.Context, opName string) {
+	h.started = append(h.started, opName)
+}
+
+func (h *recordingMetricsHook) RequestFinished(ctx context.Context, opName string, duration time.Duration, err error) {
+	h.finished = append(h.finished, opName)
+	h.errs = append(h.errs, err)
+}
+
+func TestWithMetrics(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fail") != "" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	hook := &recordingMetricsHook{}
+	ctx := context.Background()
+	err := NewClient(server.URL, nil, WithMetrics(hook)).MakeRequest(ctx,
+		&Request{Query: "query q { f }", OpName: "q"}, &Response{})
+	require.NoError(t, err)
+	err = NewClient(server.URL+"?fail=1", nil, WithMetrics(hook)).MakeRequest(ctx,
+		&Request{Query: "query r { f }", OpName: "r"}, &Response{})
+	require.Error(t, err)
+
+	assert.Equal(t, []string{"q", "r"}, hook.started)
+	assert.Equal(t, []string{"q", "r"}, hook.finished)
+	require.Len(t, hook.errs, 2)
+	assert.NoError(t, hook.errs[0])
+	assert.Equal(t, err, hook.errs[1])
+}
+
+// requestIDMetricsHook is a MetricsHook which records the request ID of
+// each request.
+type requestIDMetricsHook struct{ ids []string }
+
+func (h *requestIDMetricsHook) RequestStarted(ctx context.Context, opName string) {
+	h.ids = append(h.ids, RequestIDFromContext(ctx))
+}
+
+func (h *requestIDMetricsHook) RequestFinished(context.Context, string, time.Duration, error) {}
+
+func TestWithRequestID(t *testing.T) {
+	var sent []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Header.Get("X-Request-ID"))
+		if r.URL.Query().Get("fail") != "" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	hook := &requestIDMetricsHook{}
+	opts := []ClientOption{WithRequestID("X-Request-ID"), WithMetrics(hook)}
+	req := &Request{Query: "query q { f }", OpName: "q"}
+	ctx := context.Background()
+
+	err := NewClient(server.URL, nil, opts...).MakeRequest(ctx, req, &Response{})
+	require.NoError(t, err)
+	err = NewClient(server.URL, nil, opts...).MakeRequest(
+		ContextWithRequestID(ctx, "incoming-id"), req, &Response{})
+	require.NoError(t, err)
+	err = NewClient(server.URL+"?fail=1", nil, opts...).MakeRequest(ctx, req, &Response{})
+	require.Error(t, err)
+
+	require.Len(t, sent, 3)
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, sent[0])
+	assert.Equal(t, "incoming-id", sent[1])
+	assert.NotEqual(t, sent[0], sent[2])
+	assert.Equal(t, sent, hook.ids)
+
+	var idErr *RequestIDError
+	require.ErrorAs(t, err, &idErr)
+	assert.Equal(t, sent[2], idErr.RequestID)
+	assert.Contains(t, err.Error(), "500 Internal Server Error")
+	assert.Contains(t, err.Error(), "(request ID "+sent[2]+")")
+}
+
+// benchmarkResponse returns a response body of about the given size, with a
+// list of objects like a typical genqlient query might return.
+func benchmarkResponse(size int) []byte {
+	var b strings.Builder
+	b.WriteString(`{"data": {"users": [`)
+	for i := 0; b.Len() < size-len(`]}}`); i++ {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, `{"id": "%d", "name": "User %d", "active": true}`, i, i)
+	}
+	b.WriteString(`]}}`)
+	return []byte(b.String())
+}
+
+// BenchmarkDecodeResponse compares streaming responses to reading them in
+// full, to choose smallResponseSize.
+func BenchmarkDecodeResponse(b *testing.B) {
+	type user struct {
+		ID     string `json:"id"`
+		Name   string `json:"name"`
+		Active bool   `json:"active"`
+	}
+	for _, size := range []int{256, 4 << 10, 64 << 10, 1 << 20} {
+		body := benchmarkResponse(size)
+		for name, decode := range map[string]func(io.Reader, interface{}) error{
+			"stream": func(r io.Reader, target interface{}) error {
+				return json.NewDecoder(r).Decode(target)
+			},
+			"unmarshal": func(r io.Reader, target interface{}) error {
+				return unmarshalResponse(r, int64(len(body)), target)
+			},
+		} {
+			b.Run(fmt.Sprintf("%d/%s", size, name), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					var data struct{ Users []user }
+					err := decode(bytes.NewReader(body), &Response{Data: &data})
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func reverse(b []byte) []byte {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return b
+}
+
+func TestWithResponseDecompression(t *testing.T) {
+	var gotAcceptEncoding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAcceptEncoding = r.Header.Get("Accept-Encoding")
+		w.Header().Set("Content-Type", "application/json")
+		body := `{"data": {"f": "` + r.URL.Query().Get("encoding") + `"}}`
+		switch r.URL.Query().Get("encoding") {
+		case "gzip":
+			w.Header().Set("Content-Encoding", "gzip")
+			gz := gzip.NewWriter(w)
+			_, err := gz.Write([]byte(body))
+			assert.NoError(t, err)
+			assert.NoError(t, gz.Close())
+		case "deflate":
+			w.Header().Set("Content-Encoding", "deflate")
+			zw := zlib.NewWriter(w)
+			_, err := zw.Write([]byte(body))
+			assert.NoError(t, err)
+			assert.NoError(t, zw.Close())
+		case "reversed":
+			w.Header().Set("Content-Encoding", "reversed")
+			_, err := w.Write(reverse([]byte(body)))
+			assert.NoError(t, err)
+		default:
+			w.Header().Set("Content-Encoding", r.URL.Query().Get("encoding"))
+			_, err := w.Write([]byte(body))
+			assert.NoError(t, err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	var reversed *closeRecorder
+	opt := WithResponseDecompression(map[string]DecompressFunc{
+		"reversed": func(body io.Reader) (io.Reader, error) {
+			b, err := io.ReadAll(body)
+			reversed = &closeRecorder{Reader: bytes.NewReader(reverse(b))}
+			return reversed, err
+		},
+	})
+
+	for _, encoding := range []string{"", "identity", "gzip", "deflate", "reversed"} {
+		t.Run(encoding, func(t *testing.T) {
+			var data struct{ F string }
+			client := NewClient(server.URL+"?encoding="+encoding, nil, opt)
+			err := client.MakeRequest(context.Background(),
+				&Request{Query: "query q { f }", OpName: "q"}, &Response{Data: &data})
+			require.NoError(t, err)
+			assert.Equal(t, encoding, data.F)
+			assert.Equal(t, "deflate, gzip, reversed", gotAcceptEncoding)
+			if encoding == "reversed" {
+				assert.True(t, reversed.closed)
+			}
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		client := NewClient(server.URL+"?encoding=zstd", nil, opt)
+		err := client.MakeRequest(context.Background(),
+			&Request{Query: "query q { f }", OpName: "q"}, &Response{})
+		assert.EqualError(t, err, `unsupported response Content-Encoding "zstd"`)
+	})
+}
+
+// closeRecorder is an io.ReadCloser which records whether it was closed.
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestWithEndpoint(t *testing.T) {
+	var gotServers []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotServers = append(gotServers, "default")
+	})
+	other := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotServers = append(gotServers, "other")
+	})
+
+	for _, client := range []Client{
+		NewClient(server.URL, nil),
+		NewClientUsingGet(server.URL, nil),
+	} {
+		ctx := context.Background()
+		err := client.MakeRequest(ctx,
+			&Request{Query: "query q { f }", OpName: "q"}, &Response{})
+		require.NoError(t, err)
+
+		ctx = ContextWithOptions(ctx, WithEndpoint(other.URL))
+		err = client.MakeRequest(ctx,
+			&Request{Query: "query q { f }", OpName: "q"}, &Response{})
+		require.NoError(t, err)
+	}
+
+	// File uploads, too.
+	ctx := ContextWithOptions(context.Background(), WithEndpoint(other.URL))
+	err := NewClient(server.URL, nil).MakeRequest(ctx, &Request{
+		Query:     "mutation m($file: Upload!) { upload(file: $file) }",
+		OpName:    "m",
+		Variables: &uploadVariables{File: Upload{FileName: "a.txt", Body: strings.NewReader("hello")}},
+	}, &Response{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"default", "other", "default", "other", "other"}, gotServers)
+}
+
+func TestWithRequestModifier(t *testing.T) {
+	var gotQueries, gotTenants []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string
+			Variables struct{ Tenant string }
+		}
+		switch {
+		case r.Method == http.MethodGet:
+			req.Query = r.URL.Query().Get("query")
+			assert.NoError(t, json.Unmarshal([]byte(r.URL.Query().Get("variables")), &req.Variables))
+		case strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/"):
+			assert.NoError(t, json.Unmarshal([]byte(r.FormValue("operations")), &req))
+		default:
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		}
+		gotQueries = append(gotQueries, req.Query)
+		gotTenants = append(gotTenants, req.Variables.Tenant)
+	})
+
+	type tenantVariables struct {
+		Tenant string `json:"tenant"`
+	}
+	type uploadTenantVariables struct {
+		File   Upload `json:"file"`
+		Tenant string `json:"tenant"`
+	}
+	opts := []ClientOption{
+		WithRequestModifier(func(req *Request) {
+			switch vars := req.Variables.(type) {
+			case *tenantVariables:
+				vars.Tenant = "acme"
+			case *uploadTenantVariables:
+				vars.Tenant = "acme"
+			}
+		}),
+		WithRequestModifier(func(req *Request) {
+			req.Query = strings.TrimSpace(req.Query)
+		}),
+	}
+
+	ctx := context.Background()
+	for _, client := range []Client{
+		NewClient(server.URL, nil, opts...),
+		NewClientUsingGet(server.URL, nil, opts...),
+	} {
+		err := client.MakeRequest(ctx, &Request{
+			Query:     "  query q($tenant: String!) { f(tenant: $tenant) }  ",
+			OpName:    "q",
+			Variables: &tenantVariables{},
+		}, &Response{})
+		require.NoError(t, err)
+	}
+	err := NewClient(server.URL, nil, opts...).MakeRequest(ctx, &Request{
+		Query:  "  mutation m($file: Upload!, $tenant: String!) { f(file: $file, tenant: $tenant) }  ",
+		OpName: "m",
+		Variables: &uploadTenantVariables{
+			File: Upload{FileName: "a.txt", Body: strings.NewReader("hello")},
+		},
+	}, &Response{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"query q($tenant: String!) { f(tenant: $tenant) }",
+		"query q($tenant: String!) { f(tenant: $tenant) }",
+		"mutation m($file: Upload!, $tenant: String!) { f(file: $file, tenant: $tenant) }",
+	}, gotQueries)
+	assert.Equal(t, []string{"acme", "acme", "acme"}, gotTenants)
+}
+
+func TestWithRequestModifierCopiesRequest(t *testing.T) {
+	var gotQueries []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Query string }
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		gotQueries = append(gotQueries, body.Query)
+	})
+	client := NewClient(server.URL, nil,
+		WithRequestModifier(func(req *Request) { req.Query += " # modified" }))
+
+	// Sending the same request twice, as a retrying client would, modifies
+	// it just once each time, and leaves the caller's request alone.
+	req := &Request{Query: "query q { f }", OpName: "q"}
+	for i := 0; i < 2; i++ {
+		require.NoError(t, client.MakeRequest(context.Background(), req, &Response{}))
+	}
+	assert.Equal(t, "query q { f }", req.Query)
+	assert.Equal(t, []string{"query q { f } # modified", "query q { f } # modified"}, gotQueries)
+}
+
+func TestWithoutOperationName(t *testing.T) {
+	var gotOpNames []interface{}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		switch {
+		case r.Method == http.MethodGet:
+			req = map[string]interface{}{}
+			for key := range r.URL.Query() {
+				req[key] = r.URL.Query().Get(key)
+			}
+		case strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/"):
+			assert.NoError(t, json.Unmarshal([]byte(r.FormValue("operations")), &req))
+		default:
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		}
+		gotOpNames = append(gotOpNames, req["operationName"])
+	})
+
+	type uploadVariables struct {
+		File Upload `json:"file"`
+	}
+	ctx := context.Background()
+	for _, client := range []Client{
+		NewClient(server.URL, nil, WithoutOperationName()),
+		NewClientUsingGet(server.URL, nil, WithoutOperationName()),
+		NewClient(server.URL, nil),
+	} {
+		req := &Request{Query: "query q { f }", OpName: "q"}
+		err := client.MakeRequest(ctx, req, &Response{})
+		require.NoError(t, err)
+		assert.Equal(t, "q", req.OpName) // the caller's request is unchanged
+	}
+	err := NewClient(server.URL, nil, WithoutOperationName()).MakeRequest(ctx, &Request{
+		Query:     "mutation m($file: Upload!) { f(file: $file) }",
+		OpName:    "m",
+		Variables: &uploadVariables{File: Upload{FileName: "a.txt", Body: strings.NewReader("hello")}},
+	}, &Response{})
+	require.NoError(t, err)
+	// Without the option, an empty operation name is still sent.
+	err = NewClient(server.URL, nil).MakeRequest(ctx, &Request{Query: "{ f }"}, &Response{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []interface{}{nil, nil, "q", nil, ""}, gotOpNames)
+}
+
+func TestVariablesFunc(t *testing.T) {
+	var gotNonces []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables struct{ Nonce string }
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		gotNonces = append(gotNonces, req.Variables.Nonce)
+	})
+
+	calls := 0
+	req := &Request{
+		Query:  "query q($nonce: String!) { f(nonce: $nonce) }",
+		OpName: "q",
+		VariablesFunc: func(ctx context.Context) (interface{}, error) {
+			calls++
+			return map[string]string{"nonce": strconv.Itoa(calls)}, nil
+		},
+	}
+	client := NewClient(server.URL, nil)
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		require.NoError(t, client.MakeRequest(ctx, req, &Response{}))
+	}
+	assert.Equal(t, []string{"1", "2"}, gotNonces)
+	assert.Nil(t, req.Variables) // the caller's request is unchanged
+
+	errNonce := errors.New("out of nonces")
+	req.VariablesFunc = func(ctx context.Context) (interface{}, error) {
+		return nil, errNonce
+	}
+	err := client.MakeRequest(ctx, req, &Response{})
+	assert.ErrorIs(t, err, errNonce)
+	assert.Len(t, gotNonces, 2) // no request was sent
+}
+
+func TestWithRawResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fail") != "" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		_, err := w.Write([]byte("\x00binary file contents\xff"))
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	var body io.ReadCloser
+	ctx := ContextWithOptions(context.Background(),
+		WithRawResponse(&body), WithTimeout(time.Minute))
+	resp := &Response{}
+	err := NewClient(server.URL, nil).MakeRequest(ctx,
+		&Request{Query: "query q { file }", OpName: "q"}, resp)
+	require.NoError(t, err)
+	require.NotNil(t, body)
+	b, err := io.ReadAll(body) // still readable, despite the timeout
+	require.NoError(t, err)
+	assert.NoError(t, body.Close())
+	assert.Equal(t, "\x00binary file contents\xff", string(b))
+	assert.Nil(t, resp.Data)
+
+	body = nil
+	err = NewClient(server.URL+"?fail=1", nil).MakeRequest(ctx,
+		&Request{Query: "query q { file }", OpName: "q"}, resp)
+	assert.EqualError(t, err,
+		"returned error 500 Internal Server Error: \x00binary file contents\xff")
+	assert.Nil(t, body)
+}
+
+func TestWithRequestSigner(t *testing.T) {
+	key := []byte("secret")
+	sign := func(body []byte) string {
+		mac := hmac.New(sha256.New, key)
+		mac.Write(body)
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	var gotValid []bool
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		gotValid = append(gotValid, r.Header.Get("X-Signature") == sign(body))
+	})
+
+	signer := WithRequestSigner(func(body []byte) (string, string, error) {
+		return "X-Signature", sign(body), nil
+	})
+	ctx := context.Background()
+	for _, client := range []Client{
+		NewClient(server.URL, nil, signer),
+		NewClientUsingGet(server.URL, nil, signer),
+	} {
+		err := client.MakeRequest(ctx,
+			&Request{Query: "query q { f }", OpName: "q"}, &Response{})
+		require.NoError(t, err)
+	}
+	err := NewClient(server.URL, nil, signer).MakeRequest(ctx, &Request{
+		Query:     "mutation m($file: Upload!) { upload(file: $file) }",
+		OpName:    "m",
+		Variables: &uploadVariables{File: Upload{FileName: "a.txt", Body: strings.NewReader("hello")}},
+	}, &Response{})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, true, true}, gotValid)
+
+	errNoKey := errors.New("no signing key")
+	err = NewClient(server.URL, nil, WithRequestSigner(
+		func(body []byte) (string, string, error) { return "", "", errNoKey },
+	)).MakeRequest(ctx, &Request{Query: "query q { f }", OpName: "q"}, &Response{})
+	assert.ErrorIs(t, err, errNoKey)
+	assert.Len(t, gotValid, 3) // no request was sent
+}
+
+func TestWithErrorClassifier(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		code := r.URL.Query().Get("code")
+		if code == "" {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"data": null, "errors": [{"message": "oops", ` +
+			`"extensions": {"code": "` + code + `"}}]}`))
+		assert.NoError(t, err)
+	})
+
+	errNotFound := errors.New("not found")
+	classifier := WithErrorClassifier(func(errs gqlerror.List) error {
+		for _, err := range errs {
+			if err.Extensions["code"] == "NOT_FOUND" {
+				return errNotFound
+			}
+		}
+		return nil
+	})
+
+	makeRequest := func(code string) error {
+		return NewClient(server.URL+"?code="+code, nil, classifier).MakeRequest(
+			context.Background(),
+			&Request{Query: "query q { f }", OpName: "q"}, &Response{})
+	}
+
+	require.NoError(t, makeRequest(""))
+
+	err := makeRequest("NOT_FOUND")
+	assert.ErrorIs(t, err, errNotFound)
+	var errList gqlerror.List
+	require.ErrorAs(t, err, &errList)
+	assert.Equal(t, "oops", errList[0].Message)
+	assert.EqualError(t, err, "not found: input: oops")
+
+	err = makeRequest("INTERNAL")
+	assert.NotErrorIs(t, err, errNotFound)
+	require.ErrorAs(t, err, &errList)
+	assert.Equal(t, "oops", errList[0].Message)
+}
+
+func TestWithErrorWrapper(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fail") != "" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	var gotOpNames []string
+	wrapper := WithErrorWrapper(func(opName string, err error) error {
+		gotOpNames = append(gotOpNames, opName)
+		return fmt.Errorf("operation %s: %w", opName, err)
+	})
+
+	makeRequest := func(url string, opts ...ClientOption) error {
+		return NewClient(url, nil, opts...).MakeRequest(context.Background(),
+			&Request{Query: "query q { f }", OpName: "q"}, &Response{})
+	}
+
+	// Successful requests aren't wrapped.
+	require.NoError(t, makeRequest(server.URL, wrapper))
+	assert.Empty(t, gotOpNames)
+
+	err := makeRequest(server.URL+"?fail=1", wrapper)
+	assert.Equal(t, []string{"q"}, gotOpNames)
+	require.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "operation q: returned error 500"), err.Error())
+
+	// It wraps errors from the other options, too.
+	err = makeRequest(server.URL, wrapper, WithMaxComplexity(1), WithRequestModifier(func(req *Request) {
+		req.Complexity = 2
+	}))
+	assert.ErrorIs(t, err, ErrComplexityExceeded)
+	assert.EqualError(t, err, "operation q: "+ErrComplexityExceeded.Error()+
+		": q has complexity 2, but the maximum is 1")
+}
+
+func TestWithResumableUploads(t *testing.T) {
+	type variables struct {
+		Small Upload `json:"small"`
+		Large Upload `json:"large"`
+	}
+
+	var gotVariables map[string]interface{}
+	var gotFiles []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotVariables, gotFiles = nil, nil
+		var req struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+			err := json.Unmarshal([]byte(r.FormValue("operations")), &req)
+			assert.NoError(t, err)
+			for _, headers := range r.MultipartForm.File {
+				gotFiles = append(gotFiles, headers[0].Filename)
+			}
+		} else {
+			err := json.NewDecoder(r.Body).Decode(&req)
+			assert.NoError(t, err)
+		}
+		gotVariables = req.Variables
+	})
+
+	var uploaded []string
+	resumable := WithResumableUploads(10, func(ctx context.Context, file Upload) (interface{}, error) {
+		body, err := io.ReadAll(file.Body)
+		if err != nil {
+			return nil, err
+		}
+		uploaded = append(uploaded, string(body))
+		return "https://uploads.example/" + file.FileName, nil
+	})
+	client := NewClient(server.URL, nil, resumable)
+	makeRequest := func(vars *variables) error {
+		return client.MakeRequest(context.Background(), &Request{
+			Query:     "mutation m($small: Upload!, $large: Upload!) { f(small: $small, large: $large) }",
+			OpName:    "m",
+			Variables: vars,
+		}, &Response{})
+	}
+
+	// The large file is uploaded resumably, and the small one in the
+	// multipart request.
+	err := makeRequest(&variables{
+		Small: Upload{FileName: "small.txt", Body: strings.NewReader("hello")},
+		Large: Upload{FileName: "large.txt", Body: strings.NewReader("hello, world")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hello, world"}, uploaded)
+	assert.Equal(t, []string{"small.txt"}, gotFiles)
+	assert.Equal(t, "https://uploads.example/large.txt", gotVariables["large"])
+
+	// If all files are uploaded resumably, the request is plain JSON.
+	uploaded = nil
+	err = makeRequest(&variables{
+		Small: Upload{FileName: "a.txt", Body: strings.NewReader("hi"), Size: 20},
+		Large: Upload{FileName: "b.txt", Body: strings.NewReader("hello, world")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hi", "hello, world"}, uploaded)
+	assert.Empty(t, gotFiles)
+	assert.Equal(t, map[string]interface{}{
+		"small": "https://uploads.example/a.txt",
+		"large": "https://uploads.example/b.txt",
+	}, gotVariables)
+
+	// Files of unknown size are sent in the multipart request.
+	uploaded = nil
+	err = makeRequest(&variables{
+		Small: Upload{FileName: "a.txt", Body: io.MultiReader(strings.NewReader("hello, world"))},
+		Large: Upload{FileName: "b.txt", Body: io.MultiReader(strings.NewReader("hello, world"))},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, uploaded)
+	assert.ElementsMatch(t, []string{"a.txt", "b.txt"}, gotFiles)
+
+	errUpload := errors.New("connection reset")
+	gotVariables = nil
+	err = NewClient(server.URL, nil, WithResumableUploads(0,
+		func(ctx context.Context, file Upload) (interface{}, error) { return nil, errUpload },
+	)).MakeRequest(context.Background(), &Request{
+		Query:     "mutation m($file: Upload!) { upload(file: $file) }",
+		OpName:    "m",
+		Variables: &uploadVariables{File: Upload{FileName: "a.txt", Body: strings.NewReader("hello")}},
+	}, &Response{})
+	assert.ErrorIs(t, err, errUpload)
+	assert.Nil(t, gotVariables) // no request was sent
+}
+
+func TestErrNoData(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(r.URL.Query().Get("body")))
+		assert.NoError(t, err)
+	})
+
+	errFailed := errors.New("failed")
+	classifier := WithErrorClassifier(func(errs gqlerror.List) error { return errFailed })
+
+	tests := []struct {
+		name   string
+		body   string
+		noData bool
+	}{
+		{"NoData", `{"errors": [{"message": "oops"}]}`, true},
+		{"NullData", `{"data": null, "errors": [{"message": "oops"}]}`, true},
+		{"PartialData", `{"data": {"f": 1, "g": null}, "errors": [{"message": "oops"}]}`, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, opts := range [][]ClientOption{nil, {classifier}} {
+				var data struct{ F int }
+				resp := &Response{Data: &data}
+				err := NewClient(server.URL+"?body="+url.QueryEscape(test.body), nil, opts...).
+					MakeRequest(context.Background(),
+						&Request{Query: "query q { f g }", OpName: "q"}, resp)
+
+				var errList gqlerror.List
+				require.ErrorAs(t, err, &errList)
+				assert.Equal(t, "oops", errList[0].Message)
+				if test.noData {
+					assert.ErrorIs(t, err, ErrNoData)
+					assert.Equal(t, 0, data.F)
+				} else {
+					assert.NotErrorIs(t, err, ErrNoData)
+					assert.Equal(t, 1, data.F)
+				}
+				if opts != nil {
+					assert.ErrorIs(t, err, errFailed)
+				}
+			}
+		})
+	}
+
+	var data struct{ F int }
+	resp := &Response{Data: &data}
+	err := NewClient(server.URL+"?body="+url.QueryEscape(`{"data": {"f": 1}}`), nil).
+		MakeRequest(context.Background(), &Request{Query: "query q { f }", OpName: "q"}, resp)
+	require.NoError(t, err)
+	assert.Equal(t, 1, data.F)
+	assert.Same(t, &data, resp.Data)
+}
+
+func TestWithResponseUnwrapper(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"result": {"data": {"f": 1}}}`))
+		assert.NoError(t, err)
+	})
+
+	unwrapper := WithResponseUnwrapper(func(body []byte) ([]byte, error) {
+		var envelope struct{ Result json.RawMessage }
+		err := json.Unmarshal(body, &envelope)
+		return envelope.Result, err
+	})
+	for _, client := range []Client{
+		NewClient(server.URL, nil, unwrapper),
+		NewClientUsingGet(server.URL, nil, unwrapper),
+	} {
+		var data struct{ F int }
+		err := client.MakeRequest(context.Background(),
+			&Request{Query: "query q { f }", OpName: "q"}, &Response{Data: &data})
+		require.NoError(t, err)
+		assert.Equal(t, 1, data.F)
+	}
+
+	errBadEnvelope := errors.New("bad envelope")
+	err := NewClient(server.URL, nil, WithResponseUnwrapper(
+		func(body []byte) ([]byte, error) { return nil, errBadEnvelope },
+	)).MakeRequest(context.Background(),
+		&Request{Query: "query q { f }", OpName: "q"}, &Response{})
+	assert.ErrorIs(t, err, errBadEnvelope)
+}
+
+func TestWithDisallowUnknownFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{
+			"data": {"f": 1, "g": 2},
+			"errors": [{"message": "oops", "code": "E"}],
+			"extensions": {"cost": 3}
+		}`))
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	var data struct{ F int }
+	err := NewClient(server.URL, nil).MakeRequest(context.Background(),
+		&Request{Query: "query q { f g }", OpName: "q"}, &Response{Data: &data})
+	var errs gqlerror.List
+	require.ErrorAs(t, err, &errs)
+	assert.Equal(t, 1, data.F)
+
+	var allData struct{ F, G int }
+	err = NewClient(server.URL, nil, WithDisallowUnknownFields()).MakeRequest(
+		context.Background(),
+		&Request{Query: "query q { f g }", OpName: "q"}, &Response{Data: &allData})
+	require.ErrorAs(t, err, &errs)
+	assert.Equal(t, "oops", errs[0].Message)
+	assert.Equal(t, 2, allData.G)
+
+	err = NewClient(server.URL, nil, WithDisallowUnknownFields()).MakeRequest(
+		context.Background(),
+		&Request{Query: "query q { f g }", OpName: "q"}, &Response{Data: &data})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown field "g"`)
+}
+
+func TestResponseEnvelope(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{
+			"data": {"f": 1},
+			"extensions": {"cost": 3},
+			"requestId": "abc"
+		}`))
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	type envelope struct {
+		Response
+		RequestID string `json:"requestId"`
+	}
+	var data struct{ F int }
+	resp := &envelope{Response: Response{Data: &data}}
+	resp.Envelope = resp
+	err := NewClient(server.URL, nil).MakeRequest(context.Background(),
+		&Request{Query: "query q { f }", OpName: "q"}, &resp.Response)
+	require.NoError(t, err)
+	assert.Equal(t, 1, data.F)
+	assert.Equal(t, "abc", resp.RequestID)
+	assert.Equal(t, map[string]interface{}{"cost": 3.0}, resp.Extensions)
+}
+
+type canaryKey struct{}
+
+func TestWithEndpointResolver(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"data": {}}`))
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	errNoRoute := errors.New("no route")
+	resolver := WithEndpointResolver(func(ctx context.Context, req *Request) (string, error) {
+		switch ctx.Value(canaryKey{}) {
+		case nil:
+			return "", nil
+		case true:
+			return server.URL + "/canary", nil
+		default:
+			return "", errNoRoute
+		}
+	})
+	canaryCtx := context.WithValue(context.Background(), canaryKey{}, true)
+
+	post := NewClient(server.URL+"/main", nil, resolver)
+	get := NewClientUsingGet(server.URL+"/main", nil, resolver)
+	query := &Request{Query: "query q { f }", OpName: "q"}
+	upload := &Request{
+		Query:     "mutation m($file: Upload!) { f(file: $file) }",
+		OpName:    "m",
+		Variables: &uploadVariables{File: Upload{FileName: "a.txt", Body: strings.NewReader("hello")}},
+	}
+
+	require.NoError(t, post.MakeRequest(canaryCtx, query, &Response{}))
+	require.NoError(t, get.MakeRequest(canaryCtx, query, &Response{}))
+	require.NoError(t, post.MakeRequest(canaryCtx, upload, &Response{}))
+	require.NoError(t, post.MakeRequest(context.Background(), query, &Response{}))
+	require.NoError(t, post.MakeRequest(
+		ContextWithOptions(context.Background(), WithEndpoint(server.URL+"/other")),
+		query, &Response{}))
+	assert.Equal(t, []string{"/canary", "/canary", "/canary", "/main", "/other"}, paths)
+
+	err := post.MakeRequest(
+		context.WithValue(context.Background(), canaryKey{}, false), query, &Response{})
+	assert.ErrorIs(t, err, errNoRoute)
+	assert.Len(t, paths, 5)
+}
+
+func TestWithBearerToken(t *testing.T) {
+	var gotAuth []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = append(gotAuth, r.Header.Get("Authorization"))
+	})
+	query := &Request{Query: "query q { f }", OpName: "q"}
+
+	for _, client := range []Client{
+		NewClient(server.URL, nil, WithBearerToken("s3cr3t")),
+		NewClientUsingGet(server.URL, nil, WithBearerToken("s3cr3t")),
+	} {
+		require.NoError(t, client.MakeRequest(context.Background(), query, &Response{}))
+	}
+
+	tokens := []string{"first", "", "second", "third"}
+	client := NewClient(server.URL, nil, WithBearerTokenFunc(func() string {
+		token := tokens[0]
+		tokens = tokens[1:]
+		return token
+	}))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, client.MakeRequest(context.Background(), query, &Response{}))
+	}
+
+	// Per-request headers take precedence.
+	require.NoError(t, client.MakeRequest(
+		ContextWithOptions(context.Background(), WithHeader("Authorization", "Basic abc")),
+		query, &Response{}))
+
+	assert.Equal(t, []string{
+		"Bearer s3cr3t", "Bearer s3cr3t", "Bearer first", "", "Bearer second", "Basic abc",
+	}, gotAuth)
+}
+
+type traceparentKey struct{}
+
+func TestWithTraceparent(t *testing.T) {
+	var got []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("traceparent"))
+	})
+	traceparent := WithTraceparent(func(ctx context.Context) string {
+		traceparent, _ := ctx.Value(traceparentKey{}).(string)
+		return traceparent
+	})
+	const want = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	ctx := context.WithValue(context.Background(), traceparentKey{}, want)
+
+	upload := &Request{
+		Query:     "mutation m($file: Upload!) { f(file: $file) }",
+		OpName:    "m",
+		Variables: &uploadVariables{File: Upload{FileName: "a.txt", Body: strings.NewReader("hello")}},
+	}
+	query := &Request{Query: "query q { f }", OpName: "q"}
+	for _, tc := range []struct {
+		client Client
+		req    *Request
+	}{
+		{NewClient(server.URL, nil, traceparent), query},
+		{NewClientUsingGet(server.URL, nil, traceparent), query},
+		{NewClientUsingFormPost(server.URL, nil, traceparent), query},
+		{NewClient(server.URL, nil, traceparent), upload},
+	} {
+		require.NoError(t, tc.client.MakeRequest(ctx, tc.req, &Response{}))
+	}
+
+	// With no traceparent, the header is omitted.
+	require.NoError(t, NewClient(server.URL, nil, traceparent).MakeRequest(
+		context.Background(), query, &Response{}))
+
+	assert.Equal(t, []string{want, want, want, want, ""}, got)
+}
+
+func TestWithRequestFieldNames(t *testing.T) {
+	var got []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet:
+			got = append(got, r.URL.RawQuery)
+		case strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/"):
+			got = append(got, r.FormValue("operations"), r.FormValue("map"))
+		default:
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			got = append(got, string(body))
+		}
+	})
+	names := WithRequestFieldNames("q", "vars", "op")
+
+	upload := &Request{
+		Query:     "mutation m($file: Upload!) { f(file: $file) }",
+		OpName:    "m",
+		Variables: &uploadVariables{File: Upload{FileName: "a.txt", Body: strings.NewReader("hello")}},
+	}
+	query := &Request{Query: "query q($id: ID) { f(id: $id) }", OpName: "q", Variables: Vars{"id": "1"}}
+	for _, tc := range []struct {
+		client Client
+		req    *Request
+	}{
+		{NewClient(server.URL, nil, names), query},
+		{NewClient(server.URL, nil, names), &Request{Query: "query { f }"}},
+		{NewClientUsingGet(server.URL, nil, names), query},
+		{NewClientUsingFormPost(server.URL, nil, names), query},
+		{NewClient(server.URL, nil, names), upload},
+		// An empty name leaves the default.
+		{NewClient(server.URL, nil, WithRequestFieldNames("", "", "op")), query},
+	} {
+		require.NoError(t, tc.client.MakeRequest(context.Background(), tc.req, &Response{}))
+	}
+
+	assert.Equal(t, []string{
+		`{"op":"q","q":"query q($id: ID) { f(id: $id) }","vars":{"id":"1"}}`,
+		`{"op":"","q":"query { f }"}`,
+		`op=q&q=query+q%28%24id%3A+ID%29+%7B+f%28id%3A+%24id%29+%7D&vars=%7B%22id%22%3A%221%22%7D`,
+		`op=q&q=query+q%28%24id%3A+ID%29+%7B+f%28id%3A+%24id%29+%7D&vars=%7B%22id%22%3A%221%22%7D`,
+		`{"op":"m","q":"mutation m($file: Upload!) { f(file: $file) }","vars":{"file":{"FileName":"a.txt","Body":{},"Size":0}}}`,
+		`{"0":["vars.file"]}`,
+		`{"op":"q","query":"query q($id: ID) { f(id: $id) }","variables":{"id":"1"}}`,
+	}, got)
+}
+
+func TestWithMaxComplexity(t *testing.T) {
+	requests := 0
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+
+	client := NewClient(server.URL, nil, WithMaxComplexity(10))
+	for _, complexity := range []int{0, 5, 10} {
+		req := &Request{Query: "query q { f }", OpName: "q", Complexity: complexity}
+		require.NoError(t, client.MakeRequest(context.Background(), req, &Response{}))
+	}
+	assert.Equal(t, 3, requests)
+
+	req := &Request{Query: "query q { f }", OpName: "q", Complexity: 11}
+	err := client.MakeRequest(context.Background(), req, &Response{})
+	assert.ErrorIs(t, err, ErrComplexityExceeded)
+	assert.Contains(t, err.Error(), "q has complexity 11, but the maximum is 10")
+	assert.Equal(t, 3, requests)
+}
+
+func TestWithExtraFormField(t *testing.T) {
+	var gotNames, gotValues []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		reader, err := r.MultipartReader()
+		require.NoError(t, err)
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			value, err := io.ReadAll(part)
+			require.NoError(t, err)
+			gotNames = append(gotNames, part.FormName())
+			gotValues = append(gotValues, string(value))
+		}
+	})
+
+	client := NewClient(server.URL, nil,
+		WithExtraFormField("csrf_token", "abc123"),
+		WithExtraFormField("tenant", "acme"))
+	err := client.MakeRequest(context.Background(), &Request{
+		Query:     "mutation m($file: Upload!) { f(file: $file) }",
+		OpName:    "m",
+		Variables: &uploadVariables{File: Upload{FileName: "a.txt", Body: strings.NewReader("hello")}},
+	}, &Response{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"operations", "map", "csrf_token", "tenant", "0"}, gotNames)
+	assert.Equal(t, []string{"abc123", "acme", "hello"}, gotValues[2:])
+}
+
+func TestGetWithUpload(t *testing.T) {
+	requests := 0
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+
+	newReq := func() *Request {
+		return &Request{
+			Query:     "query q($file: Upload!) { f(file: $file) }",
+			OpName:    "q",
+			Variables: &uploadVariables{File: Upload{FileName: "a.txt", Body: strings.NewReader("hello")}},
+		}
+	}
+
+	client := NewClientUsingGet(server.URL, nil)
+	err := client.MakeRequest(context.Background(), newReq(), &Response{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "file uploads are not supported over GET")
+	assert.Equal(t, 0, requests)
+
+	// Files uploaded out-of-band are fine, since they're not in the request.
+	client = NewClientUsingGet(server.URL, nil, WithResumableUploads(0,
+		func(ctx context.Context, file Upload) (interface{}, error) {
+			return "https://uploads.example.com/a.txt", nil
+		}))
+	err = client.MakeRequest(context.Background(), newReq(), &Response{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+}
+
+func TestNewClientUsingFormPost(t *testing.T) {
+	var gotMethod, gotContentType string
+	var gotForm url.Values
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotContentType = r.Header.Get("Content-Type")
+		assert.NoError(t, r.ParseForm())
+		gotForm = r.PostForm
+	})
+	client := NewClientUsingFormPost(server.URL, nil)
+
+	var data map[string]interface{}
+	err := client.MakeRequest(context.Background(), &Request{
+		Query:     "query q($id: ID!) { user(id: $id) { name } }",
+		OpName:    "q",
+		Variables: map[string]interface{}{"id": "1"},
+	}, &Response{Data: &data})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPost, gotMethod)
+	assert.Equal(t, "application/x-www-form-urlencoded", gotContentType)
+	assert.Equal(t, url.Values{
+		"query":         {"query q($id: ID!) { user(id: $id) { name } }"},
+		"operationName": {"q"},
+		"variables":     {`{"id":"1"}`},
+	}, gotForm)
+
+	err = client.MakeRequest(context.Background(), &Request{
+		Query:     "query q($file: Upload!) { f(file: $file) }",
+		OpName:    "q",
+		Variables: &uploadVariables{File: Upload{FileName: "a.txt", Body: strings.NewReader("hello")}},
+	}, &Response{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "file uploads are not supported with form-encoded requests")
+}
@@ -0,0 +1,98 @@
+package graphql
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// A DecompressFunc wraps a response body compressed with some
+// Content-Encoding, returning a reader of the decompressed body.  If that
+// reader is also an [io.Closer], the client closes it once done with the body.
+type DecompressFunc func(body io.Reader) (io.Reader, error)
+
+// WithResponseDecompression configures the client to request compressed
+// responses, and to decompress them before decoding.
+//
+// The gzip and deflate encodings are supported by default.  genqlient does
+// not itself include decoders for other encodings, such as br (brotli) or
+// zstd, since the standard library has none; to support them, pass a
+// decompressor from a third-party package, keyed by the Content-Encoding it
+// handles.  For example, using github.com/andybalholm/brotli:
+//
+//	graphql.WithResponseDecompression(map[string]graphql.DecompressFunc{
+//		"br": func(body io.Reader) (io.Reader, error) {
+//			return brotli.NewReader(body), nil
+//		},
+//	})
+//
+// Note that Go's [http.Transport] already transparently decompresses gzip
+// responses if no Accept-Encoding header is set; this option is only needed
+// for other encodings.
+func WithResponseDecompression(decompressors map[string]DecompressFunc) ClientOption {
+	return func(c *client) {
+		c.decompressors = map[string]DecompressFunc{
+			"gzip": func(body io.Reader) (io.Reader, error) {
+				return gzip.NewReader(body)
+			},
+			// (HTTP's "deflate" is zlib-wrapped; see RFC 9110 section 8.4.1.2.)
+			"deflate": func(body io.Reader) (io.Reader, error) {
+				return zlib.NewReader(body)
+			},
+		}
+		for encoding, decompress := range decompressors {
+			c.decompressors[strings.ToLower(encoding)] = decompress
+		}
+	}
+}
+
+// acceptEncoding returns the value of the Accept-Encoding header listing the
+// given decompressors' encodings.
+func acceptEncoding(decompressors map[string]DecompressFunc) string {
+	encodings := make([]string, 0, len(decompressors))
+	for encoding := range decompressors {
+		encodings = append(encodings, encoding)
+	}
+	sort.Strings(encodings)
+	return strings.Join(encodings, ", ")
+}
+
+// decompressBody returns the decompressed body of the response.  Closing it
+// closes both the decompressing reader (if any) and the response body.
+func decompressBody(httpResp *http.Response, decompressors map[string]DecompressFunc) (io.ReadCloser, error) {
+	encoding := strings.ToLower(httpResp.Header.Get("Content-Encoding"))
+	if decompressors == nil || encoding == "" || encoding == "identity" {
+		return httpResp.Body, nil
+	}
+	decompress, ok := decompressors[encoding]
+	if !ok {
+		return nil, fmt.Errorf("unsupported response Content-Encoding %q", encoding)
+	}
+	body, err := decompress(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing %v response: %w", encoding, err)
+	}
+	return &decompressedBody{Reader: body, body: httpResp.Body}, nil
+}
+
+// decompressedBody is the reader returned by a DecompressFunc, along with the
+// response body it decompresses, both of which it closes.
+type decompressedBody struct {
+	io.Reader
+	body io.Closer
+}
+
+func (b *decompressedBody) Close() error {
+	var err error
+	if closer, ok := b.Reader.(io.Closer); ok {
+		err = closer.Close()
+	}
+	if bodyErr := b.body.Close(); err == nil {
+		err = bodyErr
+	}
+	return err
+}
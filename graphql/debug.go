@@ -0,0 +1,126 @@
+//go:build genqlient_debug
+
+package graphql
+
+// This file implements the response checks of the genqlient_debug build
+// tag; see checkResponseSelection.  In builds without the tag, nodebug.go
+// replaces it with a no-op.
+
+import (
+	"encoding/json"
+	"log"
+	"strconv"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// checkResponseSelection logs a warning for each field selected by req
+// which is missing from data, the (raw) data of its response.  This usually
+// means the server (or a mock of it) doesn't implement the schema
+// genqlient's code was generated from, since the generated types can't tell
+// a missing field from a null or zero one.
+//
+// Fields with @skip or @include may be omitted, as may those of fragments
+// whose type condition doesn't match the object's __typename.  (genqlient
+// selects __typename wherever the type is abstract; if an object doesn't
+// have one, we assume its fragments apply.)
+func checkResponseSelection(req *Request, data []byte) {
+	if req == nil || req.Query == "" {
+		return // e.g. a persisted query, which we can't check
+	}
+	doc, err := parser.ParseQuery(&ast.Source{Input: req.Query})
+	if err != nil {
+		log.Printf("genqlient: warning: %s: can't check response: %v", req.OpName, err)
+		return
+	}
+	op := doc.Operations.ForName(req.OpName)
+	if op == nil {
+		return
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return // the real decode will report this
+	}
+	for _, path := range missingFields(op.SelectionSet, doc.Fragments, value, "") {
+		log.Printf("genqlient: warning: %s: field %s was selected but is missing from the response",
+			req.OpName, path)
+	}
+}
+
+// missingFields returns the paths (like "user.emails.0.address") of the
+// fields in selections which are missing from value, recursively.  path is
+// the path of value itself.
+func missingFields(
+	selections ast.SelectionSet,
+	fragments ast.FragmentDefinitionList,
+	value interface{},
+	path string,
+) []string {
+	var missing []string
+	switch value := value.(type) {
+	case []interface{}:
+		for i, elem := range value {
+			missing = append(missing,
+				missingFields(selections, fragments, elem, joinPath(path, strconv.Itoa(i)))...)
+		}
+	case map[string]interface{}:
+		typename, _ := value["__typename"].(string)
+		for _, selection := range selections {
+			switch selection := selection.(type) {
+			case *ast.Field:
+				if isConditional(selection.Directives) {
+					continue
+				}
+				name := selection.Alias
+				if name == "" {
+					name = selection.Name
+				}
+				fieldValue, ok := value[name]
+				if !ok {
+					missing = append(missing, joinPath(path, name))
+					continue
+				}
+				missing = append(missing,
+					missingFields(selection.SelectionSet, fragments, fieldValue, joinPath(path, name))...)
+			case *ast.InlineFragment:
+				if !isConditional(selection.Directives) &&
+					fragmentApplies(selection.TypeCondition, typename) {
+					missing = append(missing,
+						missingFields(selection.SelectionSet, fragments, value, path)...)
+				}
+			case *ast.FragmentSpread:
+				fragment := fragments.ForName(selection.Name)
+				if fragment != nil && !isConditional(selection.Directives) &&
+					fragmentApplies(fragment.TypeCondition, typename) {
+					missing = append(missing,
+						missingFields(fragment.SelectionSet, fragments, value, path)...)
+				}
+			}
+		}
+	default:
+		// null, or a scalar: there's nothing (more) to check.
+	}
+	return missing
+}
+
+// isConditional returns whether directives include @skip or @include, in
+// which case the server may omit the field or fragment.
+func isConditional(directives ast.DirectiveList) bool {
+	return directives.ForName("skip") != nil || directives.ForName("include") != nil
+}
+
+// fragmentApplies returns whether a fragment with the given type condition
+// applies to an object with the given __typename (or "" if unknown).  If
+// the type condition is an abstract type, we can't tell without the schema,
+// so we say no.
+func fragmentApplies(typeCondition, typename string) bool {
+	return typeCondition == "" || typename == "" || typeCondition == typename
+}
+
+func joinPath(path, elem string) string {
+	if path == "" {
+		return elem
+	}
+	return path + "." + elem
+}
@@ -0,0 +1,86 @@
+//go:build genqlient_debug
+
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// captureLog returns a buffer which receives the output of the standard
+// logger, without timestamps, for the rest of the test.
+func captureLog(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	output, flags := log.Writer(), log.Flags()
+	log.SetOutput(&buf)
+	log.SetFlags(0)
+	t.Cleanup(func() {
+		log.SetOutput(output)
+		log.SetFlags(flags)
+	})
+	return &buf
+}
+
+func TestCheckResponseSelection(t *testing.T) {
+	const query = `query q($b: Boolean!) {
+		user {
+			id
+			alias: name
+			emails { address }
+			skipped @skip(if: $b)
+			...UserFields
+		}
+		content {
+			__typename
+			... on Article { text }
+			... on Video { duration }
+		}
+	}
+	fragment UserFields on User { bio }`
+
+	for _, test := range []struct {
+		name     string
+		data     string
+		warnings []string
+	}{
+		{"Complete",
+			`{"user": {"id": "1", "alias": "a", "emails": [{"address": "x"}], "bio": ""},
+			"content": [{"__typename": "Article", "text": "t"}, {"__typename": "Video", "duration": 1}]}`,
+			nil},
+		{"Nulls", `{"user": null, "content": null}`, nil},
+		{"Missing",
+			`{"user": {"id": "1", "name": "a", "emails": [{"address": "x"}, {}]},
+			"content": [{"__typename": "Article"}, {"__typename": "Video", "duration": 1}]}`,
+			[]string{"user.alias", "user.emails.1.address", "user.bio", "content.0.text"}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			buf := captureLog(t)
+			checkResponseSelection(&Request{OpName: "q", Query: query}, []byte(test.data))
+
+			var want string
+			for _, path := range test.warnings {
+				want += "genqlient: warning: q: field " + path +
+					" was selected but is missing from the response\n"
+			}
+			assert.Equal(t, want, buf.String())
+		})
+	}
+}
+
+func TestClientChecksResponseSelection(t *testing.T) {
+	buf := captureLog(t)
+	// (newTestServer responds with empty data.)
+	client := NewClient(newTestServer(t, nil).URL, nil)
+
+	var data struct{ F string }
+	err := client.MakeRequest(context.Background(),
+		&Request{Query: "query q { f }", OpName: "q"}, &Response{Data: &data})
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"genqlient: warning: q: field f was selected but is missing from the response\n",
+		buf.String())
+}
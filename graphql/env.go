@@ -0,0 +1,45 @@
+package graphql
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// NewClientFromEnv returns a [Client] configured from environment variables
+// with the given prefix, for applications which keep their configuration in
+// the environment.  For example, with prefix "GITHUB", it reads:
+//   - GITHUB_ENDPOINT (required): the URL of the GraphQL endpoint.
+//   - GITHUB_TOKEN (optional): if set, each request is sent with the header
+//     "Authorization: Bearer <token>".
+//   - GITHUB_METHOD (optional): "POST" (the default) to return a client like
+//     [NewClient], or "GET" to return one like [NewClientUsingGet].
+//
+// The client uses [http.DefaultClient], and may be further configured by
+// passing [ClientOption] values.  For more control over the HTTP client, call
+// [NewClient] directly.
+func NewClientFromEnv(prefix string, opts ...ClientOption) (Client, error) {
+	endpointVar := prefix + "_ENDPOINT"
+	endpoint := os.Getenv(endpointVar)
+	if endpoint == "" {
+		return nil, fmt.Errorf("environment variable %s is not set", endpointVar)
+	}
+
+	method := http.MethodPost
+	methodVar := prefix + "_METHOD"
+	switch m := strings.ToUpper(os.Getenv(methodVar)); m {
+	case "", http.MethodPost:
+	case http.MethodGet:
+		method = http.MethodGet
+	default:
+		return nil, fmt.Errorf("environment variable %s must be GET or POST, not %q", methodVar, m)
+	}
+
+	if token := os.Getenv(prefix + "_TOKEN"); token != "" {
+		// (Put the token first, so the caller's options can override it.)
+		opts = append([]ClientOption{WithBearerToken(token)}, opts...)
+	}
+
+	return newClient(endpoint, nil, method, opts), nil
+}
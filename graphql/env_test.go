@@ -0,0 +1,43 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClientFromEnv(t *testing.T) {
+	var gotMethod, gotAuth string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotAuth = r.Method, r.Header.Get("Authorization")
+	})
+	makeRequest := func(client Client) error {
+		return client.MakeRequest(context.Background(),
+			&Request{Query: "query q { f }", OpName: "q"}, &Response{})
+	}
+
+	_, err := NewClientFromEnv("MYAPI")
+	assert.EqualError(t, err, "environment variable MYAPI_ENDPOINT is not set")
+
+	t.Setenv("MYAPI_ENDPOINT", server.URL)
+	client, err := NewClientFromEnv("MYAPI")
+	require.NoError(t, err)
+	require.NoError(t, makeRequest(client))
+	assert.Equal(t, http.MethodPost, gotMethod)
+	assert.Equal(t, "", gotAuth)
+
+	t.Setenv("MYAPI_TOKEN", "s3cr3t")
+	t.Setenv("MYAPI_METHOD", "get")
+	client, err = NewClientFromEnv("MYAPI")
+	require.NoError(t, err)
+	require.NoError(t, makeRequest(client))
+	assert.Equal(t, http.MethodGet, gotMethod)
+	assert.Equal(t, "Bearer s3cr3t", gotAuth)
+
+	t.Setenv("MYAPI_METHOD", "PUT")
+	_, err = NewClientFromEnv("MYAPI")
+	assert.EqualError(t, err, `environment variable MYAPI_METHOD must be GET or POST, not "PUT"`)
+}
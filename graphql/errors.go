@@ -0,0 +1,143 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// HTTPError is the error returned by the client returned by [NewClient]
+// when the server responds with an HTTP status other than 200 OK.
+type HTTPError struct {
+	// The HTTP status code, e.g. 500, and status, e.g.
+	// "500 Internal Server Error".
+	StatusCode int
+	Status     string
+	// The body of the response.
+	Body []byte
+}
+
+func (err *HTTPError) Error() string {
+	return fmt.Sprintf("returned error %v: %s", err.Status, err.Body)
+}
+
+// AnnotateError returns a human-readable description of err, which must have
+// been returned by the server for the operation op, pointing out the parts of
+// op to which it refers.  For example, for a genqlient-generated operation
+// MyQuery, you might do
+//
+//	resp, err := MyQuery(ctx, client)
+//	var errList gqlerror.List
+//	if errors.As(err, &errList) {
+//		for _, err := range errList {
+//			log.Print(graphql.AnnotateError(MyQuery_Operation, err))
+//		}
+//	}
+//
+// which might log:
+//
+//	Cannot query field "nmae" on type "User".
+//	at line 4, column 3:
+//			nmae
+//			^
+//
+// Locations which don't exist in op are described but not shown, since they
+// likely mean op isn't the operation the error is for.
+func AnnotateError(op string, err *gqlerror.Error) string {
+	var b strings.Builder
+	b.WriteString(err.Message)
+	if len(err.Path) > 0 {
+		fmt.Fprintf(&b, "\nat path %s", err.Path)
+	}
+
+	lines := strings.Split(op, "\n")
+	for _, loc := range err.Locations {
+		fmt.Fprintf(&b, "\nat line %d, column %d", loc.Line, loc.Column)
+		if loc.Line < 1 || loc.Line > len(lines) {
+			continue
+		}
+		line := []rune(strings.TrimSuffix(lines[loc.Line-1], "\r"))
+		if loc.Column < 1 || loc.Column > len(line)+1 {
+			continue
+		}
+
+		// Point at the column, keeping any tabs so the caret lines up.
+		caret := make([]rune, loc.Column-1)
+		for i, r := range line[:loc.Column-1] {
+			if r == '\t' {
+				caret[i] = '\t'
+			} else {
+				caret[i] = ' '
+			}
+		}
+		fmt.Fprintf(&b, ":\n\t%s\n\t%s^", string(line), string(caret))
+	}
+	return b.String()
+}
+
+// ErrNoData is wrapped by the error returned by [NewClient] and
+// [NewClientUsingGet] clients when the server returns GraphQL errors and no
+// data at all (i.e. the data is null or absent), as opposed to partial data
+// alongside the errors.  The error may still be unwrapped to a
+// [gqlerror.List] with the details.  For example:
+//
+//	resp, err := MyQuery(ctx, client)
+//	if errors.Is(err, graphql.ErrNoData) {
+//		// resp is blank (but still non-nil)
+//	} else if err != nil {
+//		// resp may be partly populated
+//	}
+var ErrNoData = errors.New("response has no data")
+
+// ErrComplexityExceeded is wrapped by the error returned by [NewClient] and
+// [NewClientUsingGet] clients configured with [WithMaxComplexity] when a
+// request's complexity exceeds the maximum.  No request is sent.
+var ErrComplexityExceeded = errors.New("operation complexity exceeds maximum")
+
+// ErrClientShutdown is returned by [NewClient] and [NewClientUsingGet]
+// clients for requests made after [ShutdownClient] has been called.
+var ErrClientShutdown = errors.New("client is shut down")
+
+// noDataError is the error returned when the response has errors and no
+// data; see [ErrNoData].
+type noDataError struct {
+	errors gqlerror.List
+}
+
+func (err *noDataError) Error() string {
+	return err.errors.Error()
+}
+
+func (err *noDataError) Unwrap() []error {
+	return []error{ErrNoData, err.errors}
+}
+
+// classifiedError is the error returned by a client configured with
+// [WithErrorClassifier]: the error we would otherwise return, along with
+// the classification of the server's errors.
+type classifiedError struct {
+	class error
+	err   error
+}
+
+// classifyErrors returns err, the error for the server's errors errs,
+// wrapped with the classification of errs by classify, or err itself if
+// classify returns nil.
+func classifyErrors(errs gqlerror.List, err error, classify func(gqlerror.List) error) error {
+	class := classify(errs)
+	if class == nil {
+		return err
+	}
+	return &classifiedError{class: class, err: err}
+}
+
+func (err *classifiedError) Error() string {
+	// (gqlerror.List's message ends in a newline.)
+	return fmt.Sprintf("%v: %v", err.class, strings.TrimSuffix(err.err.Error(), "\n"))
+}
+
+func (err *classifiedError) Unwrap() []error {
+	return []error{err.class, err.err}
+}
@@ -0,0 +1,67 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestAnnotateError(t *testing.T) {
+	op := `
+query GetUser {
+	user {
+		nmae
+	}
+}
+`
+	tests := []struct {
+		name string
+		err  *gqlerror.Error
+		want string
+	}{
+		{
+			"Location",
+			&gqlerror.Error{
+				Message:   `Cannot query field "nmae" on type "User".`,
+				Locations: []gqlerror.Location{{Line: 4, Column: 3}},
+			},
+			"Cannot query field \"nmae\" on type \"User\".\n" +
+				"at line 4, column 3:\n" +
+				"\t\t\tnmae\n" +
+				"\t\t\t^",
+		},
+		{
+			"PathAndLocations",
+			&gqlerror.Error{
+				Message:   "oops",
+				Path:      ast.Path{ast.PathName("user")},
+				Locations: []gqlerror.Location{{Line: 3, Column: 2}, {Line: 3, Column: 7}},
+			},
+			"oops\n" +
+				"at path user\n" +
+				"at line 3, column 2:\n" +
+				"\t\tuser {\n" +
+				"\t\t^\n" +
+				"at line 3, column 7:\n" +
+				"\t\tuser {\n" +
+				"\t\t     ^",
+		},
+		{
+			"LocationOutOfRange",
+			&gqlerror.Error{
+				Message:   "oops",
+				Locations: []gqlerror.Location{{Line: 20, Column: 1}, {Line: 2, Column: 80}},
+			},
+			"oops\nat line 20, column 1\nat line 2, column 80",
+		},
+		{"NoLocations", &gqlerror.Error{Message: "oops"}, "oops"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, AnnotateError(op, test.err))
+		})
+	}
+}
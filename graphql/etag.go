@@ -0,0 +1,91 @@
+package graphql
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// WithETagCache configures a client returned by [NewClientUsingGet] to cache
+// responses which have an ETag header, and to revalidate them: it sends the
+// cached ETag in an If-None-Match header when it makes the same request
+// again, and if the server responds "304 Not Modified", decodes the cached
+// response instead.  This saves the server the work of sending (and the
+// client of decoding) an unchanged response, but unlike [NewCachingClient]
+// each request still goes to the server.
+//
+// Requests are cached by their URL, which includes the query, operation
+// name, and variables.  Since the server sees each request, and only
+// responds 304 if the ETag matches what it would have sent, this is safe even
+// if responses vary by user.  At most maxEntries responses are cached; when
+// the cache is full, an arbitrary response is evicted.
+//
+// This option has no effect on clients which make POST requests (which are
+// not cacheable in HTTP), or on requests with [WithRawResponse].
+func WithETagCache(maxEntries int) ClientOption {
+	return func(c *client) {
+		c.etags = &etagCache{maxEntries: maxEntries, entries: map[string]*etagEntry{}}
+	}
+}
+
+// etagCache is the cache configured by [WithETagCache].
+type etagCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*etagEntry
+}
+
+// etagEntry is a single cached response.
+type etagEntry struct {
+	etag string
+	body []byte
+}
+
+func (c *etagCache) get(key string) *etagEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries[key]
+}
+
+// setIfNoneMatch adds the If-None-Match header to httpReq, if we have a
+// cached response for key.
+func (c *etagCache) setIfNoneMatch(key string, httpReq *http.Request) {
+	if entry := c.get(key); entry != nil {
+		httpReq.Header.Set("If-None-Match", entry.etag)
+	}
+}
+
+// handleResponse returns the body and status code to use for httpResp, the
+// response to the request for key: for a 304, the cached body (if we still
+// have it) and 200; for a 200 with an ETag, the (now-cached) body.
+func (c *etagCache) handleResponse(key string, httpResp *http.Response, body io.Reader) (io.Reader, int, error) {
+	switch httpResp.StatusCode {
+	case http.StatusNotModified:
+		if entry := c.get(key); entry != nil {
+			return bytes.NewReader(entry.body), http.StatusOK, nil
+		}
+	case http.StatusOK:
+		etag := httpResp.Header.Get("ETag")
+		if etag == "" || c.maxEntries <= 0 {
+			break
+		}
+		respBody, err := io.ReadAll(body)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		c.mu.Lock()
+		if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+			for k := range c.entries {
+				delete(c.entries, k)
+				break
+			}
+		}
+		c.entries[key] = &etagEntry{etag: etag, body: respBody}
+		c.mu.Unlock()
+		return bytes.NewReader(respBody), http.StatusOK, nil
+	}
+	return body, httpResp.StatusCode, nil
+}
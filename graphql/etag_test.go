@@ -0,0 +1,47 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithETagCache(t *testing.T) {
+	var ifNoneMatch []string
+	version := "1"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+		etag := `"` + r.URL.Query().Get("variables") + version + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"data": {"version": ` + version + `}}`))
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClientUsingGet(server.URL, nil, WithETagCache(10))
+	get := func(id int) int {
+		var data struct{ Version int }
+		err := client.MakeRequest(context.Background(),
+			NewRequest("query q($id: ID!) { version(id: $id) }", "q", Vars{"id": id}),
+			&Response{Data: &data})
+		require.NoError(t, err)
+		return data.Version
+	}
+
+	assert.Equal(t, 1, get(1))
+	assert.Equal(t, 1, get(1)) // from the cache, via a 304
+	assert.Equal(t, 1, get(2)) // different variables, so not cached
+	version = "2"
+	assert.Equal(t, 2, get(1)) // changed, so the server sends it
+	assert.Equal(t, 2, get(1))
+	assert.Equal(t, []string{``, `"{"id":1}1"`, ``, `"{"id":1}1"`, `"{"id":1}2"`}, ifNoneMatch)
+}
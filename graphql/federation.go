@@ -0,0 +1,11 @@
+package graphql
+
+// Representation is the default Go type for the `_Any` scalar used by Apollo
+// Federation, most commonly in the `representations` argument of the
+// `_entities` query.  Each representation is a JSON object containing the
+// entity's `__typename` and its key fields, for example:
+//
+//	graphql.Representation{"__typename": "User", "id": "123"}
+//
+// To use a different type, bind `_Any` in genqlient.yaml as usual.
+type Representation map[string]interface{}
@@ -0,0 +1,137 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// hedgedClient is the [Client] returned by [NewHedgedClient].
+type hedgedClient struct {
+	inner     Client
+	delay     time.Duration
+	maxHedges int
+}
+
+// hedgedAttempt is the result of one of the requests made by a
+// [hedgedClient].
+type hedgedAttempt struct {
+	data json.RawMessage
+	resp *Response
+	err  error
+}
+
+// NewHedgedClient returns a [Client] which makes requests with inner, and
+// if a query hasn't returned within delay, "hedges" it by making the same
+// request again, for latency-sensitive reads where the occasional slow
+// request (say, to an overloaded replica) is worth avoiding.  It makes up to
+// maxHedges additional requests, each delay after the last, and returns the
+// response of whichever finishes first, canceling the context of the others.
+//
+// A request which fails with an error other than a GraphQL error (say, a
+// network error) doesn't finish the hedged request while others are still
+// in flight; its error is returned only if all the requests made fail.
+// (NewHedgedClient doesn't retry requests; see [NewRetryingClient] for
+// that.)
+//
+// Only queries are hedged, never mutations; see [NewRetryingClient] for how
+// mutations are detected.  Requests with file uploads, which can't be
+// re-read, or [WithRawResponse], or a custom response type (see
+// [Response.Envelope]), are also made just once.  Each hedged request gets
+// its own copy of the request and of the response data.
+func NewHedgedClient(inner Client, delay time.Duration, maxHedges int) Client {
+	return &hedgedClient{inner: inner, delay: delay, maxHedges: maxHedges}
+}
+
+func (c *hedgedClient) Close() error {
+	return CloseClient(c.inner)
+}
+
+func (c *hedgedClient) Shutdown(ctx context.Context) error {
+	return ShutdownClient(ctx, c.inner)
+}
+
+func (c *hedgedClient) MakeRequest(ctx context.Context, req *Request, resp *Response) error {
+	if c.maxHedges < 1 || !mayHedge(ctx, req, resp) {
+		return c.inner.MakeRequest(ctx, req, resp)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// Once we return, cancel the requests we're no longer waiting for.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// (Buffered, so that the requests we don't wait for don't block.)
+	results := make(chan *hedgedAttempt, c.maxHedges+1)
+	attempt := func() {
+		// Each attempt gets its own copy of the request, since the inner
+		// client may modify it (say, with WithRequestModifier).
+		reqCopy := *req
+		a := &hedgedAttempt{}
+		a.resp = &Response{Data: &a.data}
+		a.err = c.inner.MakeRequest(ctx, &reqCopy, a.resp)
+		results <- a
+	}
+
+	go attempt()
+	inFlight, hedges := 1, 0
+	timer := time.NewTimer(c.delay)
+	defer timer.Stop()
+	for {
+		var hedge <-chan time.Time
+		if hedges < c.maxHedges {
+			hedge = timer.C
+		}
+		select {
+		case <-hedge:
+			hedges++
+			inFlight++
+			go attempt()
+			timer.Reset(c.delay)
+		case a := <-results:
+			inFlight--
+			// A retryable error (see isRetryable) means the server may not
+			// have processed the request, so we wait for the others.
+			if a.err == nil || !isRetryable(a.err) || inFlight == 0 {
+				return a.copyTo(resp)
+			}
+		}
+	}
+}
+
+// copyTo copies the response of a into resp, and returns its error.
+func (a *hedgedAttempt) copyTo(resp *Response) error {
+	if resp == nil {
+		return a.err
+	}
+	resp.Extensions = a.resp.Extensions
+	resp.Errors = a.resp.Errors
+	if len(a.data) > 0 && resp.Data != nil {
+		if err := json.Unmarshal(a.data, resp.Data); err != nil {
+			return err
+		}
+	}
+	return a.err
+}
+
+// mayHedge returns whether req may be hedged.
+func mayHedge(ctx context.Context, req *Request, resp *Response) bool {
+	if req.IsMutation || !isQuery(req.Query) {
+		return false
+	}
+	if resp != nil && resp.Envelope != nil {
+		return false
+	}
+	if optionsFromContext(ctx).rawResponse != nil {
+		return false
+	}
+	if req.Variables != nil {
+		files, err := findVariablesFiles("variables", req.Variables)
+		if err != nil || len(files) > 0 {
+			return false
+		}
+	}
+	return true
+}
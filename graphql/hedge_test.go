@@ -0,0 +1,147 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// slowClient is a Client which responds to its nth request (from 1) after
+// delays[n-1], with data n, or with errs[n-1] if set.  If the request's
+// context is canceled first, it records that and returns its error.
+type slowClient struct {
+	delays []time.Duration
+	errs   []error
+
+	mu       sync.Mutex
+	calls    int
+	canceled []int
+}
+
+func (c *slowClient) MakeRequest(ctx context.Context, req *Request, resp *Response) error {
+	c.mu.Lock()
+	c.calls++
+	n := c.calls
+	c.mu.Unlock()
+
+	select {
+	case <-time.After(c.delays[n-1]):
+	case <-ctx.Done():
+		c.mu.Lock()
+		c.canceled = append(c.canceled, n)
+		c.mu.Unlock()
+		return ctx.Err()
+	}
+	if n <= len(c.errs) && c.errs[n-1] != nil {
+		return c.errs[n-1]
+	}
+	return json.Unmarshal([]byte(`{"n": `+strconv.Itoa(n)+`}`), resp.Data)
+}
+
+func (c *slowClient) numCalls() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls
+}
+
+// wait waits for the requests which will be canceled to notice.
+func (c *slowClient) wait(t *testing.T, canceled int) []int {
+	assert.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return len(c.canceled) >= canceled
+	}, time.Second, time.Millisecond)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.canceled
+}
+
+func TestHedgedClient(t *testing.T) {
+	const delay = 20 * time.Millisecond
+	query := &Request{OpName: "q", Query: "query q { f }"}
+	mutation := &Request{OpName: "m", Query: "mutation m { f }", IsMutation: true}
+
+	for _, test := range []struct {
+		name         string
+		req          *Request
+		delays       []time.Duration
+		errs         []error
+		maxHedges    int
+		wantN        int
+		wantCalls    int
+		wantCanceled []int
+		wantErr      error
+	}{
+		{"Fast", query, []time.Duration{0}, nil, 2, 1, 1, nil, nil},
+		{"HedgeWins", query, []time.Duration{time.Second, 0}, nil, 2, 2, 2, []int{1}, nil},
+		{"FirstWins", query, []time.Duration{2 * delay, time.Second}, nil, 1, 1, 2, []int{2}, nil},
+		{"MaxHedges", query, []time.Duration{time.Second, time.Second, 0}, nil, 1, 0, 0, nil, context.DeadlineExceeded},
+		{"ErrorWaits", query, []time.Duration{2 * delay, 3 * delay},
+			[]error{errTransient}, 1, 2, 2, nil, nil},
+		{"AllFail", query, []time.Duration{2 * delay, 3 * delay},
+			[]error{errTransient, errTransient}, 1, 0, 2, nil, errTransient},
+		{"MutationNotHedged", mutation, []time.Duration{2 * delay}, nil, 2, 1, 1, nil, nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			inner := &slowClient{delays: test.delays, errs: test.errs}
+			client := NewHedgedClient(inner, delay, test.maxHedges)
+
+			ctx := context.Background()
+			if test.wantErr == context.DeadlineExceeded {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, 5*delay)
+				defer cancel()
+			}
+			var data countData
+			err := client.MakeRequest(ctx, test.req, &Response{Data: &data})
+			if test.wantErr != nil {
+				assert.ErrorIs(t, err, test.wantErr)
+				if test.wantErr == context.DeadlineExceeded {
+					// Only the first request and one hedge were made.
+					assert.Equal(t, 2, inner.numCalls())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantN, data.N)
+			assert.Equal(t, test.wantCalls, inner.numCalls())
+			assert.ElementsMatch(t, test.wantCanceled, inner.wait(t, len(test.wantCanceled)))
+		})
+	}
+}
+
+func TestHedgedClientCopiesRequest(t *testing.T) {
+	// Run with -race: each attempt's request modifier must get its own
+	// request.
+	var mu sync.Mutex
+	var gotQueries []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Query string }
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		mu.Lock()
+		gotQueries = append(gotQueries, body.Query)
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+	})
+	inner := NewClient(server.URL, nil,
+		WithRequestModifier(func(r *Request) { r.Query += " " }))
+	client := NewHedgedClient(inner, time.Millisecond, 2)
+
+	req := &Request{OpName: "q", Query: "query q { f }"}
+	err := client.MakeRequest(context.Background(), req, &Response{})
+	assert.NoError(t, err)
+	assert.Equal(t, "query q { f }", req.Query)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.NotEmpty(t, gotQueries)
+	for _, query := range gotQueries {
+		assert.Equal(t, "query q { f } ", query)
+	}
+}
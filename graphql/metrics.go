@@ -0,0 +1,39 @@
+package graphql
+
+import (
+	"context"
+	"time"
+)
+
+// MetricsHook is notified of each request made by a [Client] configured
+// with [WithMetrics].  It's intended for recording metrics, such as request
+// counts, latencies, and error rates, with a library like Prometheus.
+//
+// Implementations must be safe for concurrent use.
+type MetricsHook interface {
+	// RequestStarted is called before the request is sent.  opName is the
+	// name of the GraphQL operation.
+	RequestStarted(ctx context.Context, opName string)
+	// RequestFinished is called after the request completes, with its total
+	// duration (including reading the response) and the error, if any, that
+	// MakeRequest will return.
+	RequestFinished(ctx context.Context, opName string, duration time.Duration, err error)
+}
+
+// WithMetrics configures the client to call the given hook for each request.
+//
+// For example, to record request latencies by operation and result using
+// Prometheus:
+//
+//	type promHook struct{ latency *prometheus.HistogramVec }
+//
+//	func (promHook) RequestStarted(context.Context, string) {}
+//
+//	func (h promHook) RequestFinished(_ context.Context, opName string, d time.Duration, err error) {
+//		h.latency.WithLabelValues(opName, strconv.FormatBool(err == nil)).Observe(d.Seconds())
+//	}
+func WithMetrics(hook MetricsHook) ClientOption {
+	return func(c *client) {
+		c.metrics = hook
+	}
+}
@@ -0,0 +1,115 @@
+package graphql
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// A StreamedResponse is one of the responses read by [ReadNDJSONResponses].
+type StreamedResponse[T any] struct {
+	// Data is the decoded data of the response, or nil if it had none.
+	Data *T
+	// Extensions is the extensions of the response, if any.
+	Extensions map[string]interface{}
+	// Err is the response's GraphQL errors (as a [gqlerror.List]), if any,
+	// or, for the last value sent, the error which ended the stream.
+	Err error
+}
+
+// ReadNDJSONResponses reads a stream of GraphQL responses from body, one JSON
+// object per line (newline-delimited JSON, or ndjson), as some servers send
+// the updates to a subscription over a chunked HTTP response.  It decodes
+// each into a [StreamedResponse], and sends it on the returned channel.
+// Lines may be split across chunks arbitrarily; blank lines (such as
+// keep-alives) are ignored.
+//
+// The channel is closed, and body is closed, when body ends or ctx is done;
+// the caller must either read the channel until it's closed, or cancel ctx.
+// If reading or decoding the stream fails, the last value sent has the error
+// in Err; a line with GraphQL errors sends a value with those errors in Err,
+// and the stream continues.
+//
+// genqlient does not yet generate code for subscriptions, so to use this you
+// must make the request yourself, typically using [WithRawResponse] to get
+// the body:
+//
+//	var body io.ReadCloser
+//	ctx = graphql.ContextWithOptions(ctx, graphql.WithRawResponse(&body))
+//	err := client.MakeRequest(ctx, &graphql.Request{...}, nil)
+//	if err != nil { ... }
+//	for resp := range graphql.ReadNDJSONResponses[MySubscriptionResponse](ctx, body) {
+//		...
+//	}
+func ReadNDJSONResponses[T any](ctx context.Context, body io.ReadCloser) <-chan StreamedResponse[T] {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ch := make(chan StreamedResponse[T])
+	done := make(chan struct{})
+
+	// Closing body is the only way to interrupt a blocked read, so do so if
+	// ctx is done first.
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		body.Close()
+	}()
+
+	go func() {
+		defer close(ch)
+		defer close(done)
+
+		send := func(resp StreamedResponse[T]) bool {
+			select {
+			case ch <- resp:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		reader := bufio.NewReader(body)
+		for {
+			// ReadBytes returns only once it has a whole line (or the end of
+			// the stream), however the line is split across reads.
+			line, err := reader.ReadBytes('\n')
+			if line = bytes.TrimSpace(line); len(line) > 0 {
+				resp, decodeErr := decodeStreamedResponse[T](line)
+				if !send(resp) || decodeErr != nil {
+					return
+				}
+			}
+			if err != nil {
+				if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					send(StreamedResponse[T]{Err: fmt.Errorf("reading response stream: %w", err)})
+				}
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// decodeStreamedResponse decodes a single line of an ndjson response stream;
+// it returns the decoding error (if any) both in the response and separately.
+func decodeStreamedResponse[T any](line []byte) (StreamedResponse[T], error) {
+	var data *T
+	resp := Response{Data: &data}
+	if err := json.Unmarshal(line, &resp); err != nil {
+		err = fmt.Errorf("decoding response stream: %w", err)
+		return StreamedResponse[T]{Err: err}, err
+	}
+	streamed := StreamedResponse[T]{Data: data, Extensions: resp.Extensions}
+	if len(resp.Errors) > 0 {
+		streamed.Err = resp.Errors
+	}
+	return streamed, nil
+}
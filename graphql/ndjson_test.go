@@ -0,0 +1,85 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type streamedData struct{ Count int }
+
+// writeChunks writes the given chunks to a pipe, and returns its read end.
+func writeChunks(chunks ...string) io.ReadCloser {
+	r, w := io.Pipe()
+	go func() {
+		for _, chunk := range chunks {
+			if _, err := w.Write([]byte(chunk)); err != nil {
+				return
+			}
+		}
+		w.Close()
+	}()
+	return r
+}
+
+func TestReadNDJSONResponses(t *testing.T) {
+	body := writeChunks(
+		`{"data": {"count": 1}}`+"\n"+`{"data": {"co`,
+		`unt": 2}, "extensions": {"x": 1}}`,
+		"\n\n",
+		`{"data": null, "errors": [{"message": "oops"}]}`+"\n",
+		`{"data": {"count": 3}}`) // no trailing newline
+
+	var got []StreamedResponse[streamedData]
+	for resp := range ReadNDJSONResponses[streamedData](context.Background(), body) {
+		got = append(got, resp)
+	}
+
+	require.Len(t, got, 4)
+	assert.Equal(t, &streamedData{Count: 1}, got[0].Data)
+	assert.NoError(t, got[0].Err)
+	assert.Equal(t, &streamedData{Count: 2}, got[1].Data)
+	assert.Equal(t, map[string]interface{}{"x": float64(1)}, got[1].Extensions)
+	assert.Nil(t, got[2].Data)
+	var errs gqlerror.List
+	require.ErrorAs(t, got[2].Err, &errs)
+	assert.Equal(t, "oops", errs[0].Message)
+	assert.Equal(t, &streamedData{Count: 3}, got[3].Data)
+}
+
+func TestReadNDJSONResponsesInvalid(t *testing.T) {
+	body := writeChunks(`{"data": {"count": 1}}`+"\n", "not json\n", `{"data": {"count": 2}}`+"\n")
+
+	var got []StreamedResponse[streamedData]
+	for resp := range ReadNDJSONResponses[streamedData](context.Background(), body) {
+		got = append(got, resp)
+	}
+
+	require.Len(t, got, 2)
+	assert.Equal(t, &streamedData{Count: 1}, got[0].Data)
+	assert.ErrorContains(t, got[1].Err, "decoding response stream")
+}
+
+func TestReadNDJSONResponsesCancel(t *testing.T) {
+	r, w := io.Pipe()
+	t.Cleanup(func() { w.Close() })
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := ReadNDJSONResponses[streamedData](ctx, r)
+
+	go func() { _, _ = w.Write([]byte(`{"data": {"count": 1}}` + "\n")) }()
+	resp := <-ch
+	assert.Equal(t, &streamedData{Count: 1}, resp.Data)
+
+	// The reader is now blocked reading the next line; canceling closes the
+	// body, which ends the stream.
+	cancel()
+	for range ch {
+	}
+	_, err := w.Write([]byte("more\n"))
+	assert.True(t, errors.Is(err, io.ErrClosedPipe))
+}
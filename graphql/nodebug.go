@@ -0,0 +1,7 @@
+//go:build !genqlient_debug
+
+package graphql
+
+// checkResponseSelection is a no-op except in builds with the
+// genqlient_debug build tag; see debug.go.
+func checkResponseSelection(req *Request, data []byte) {}
@@ -0,0 +1,50 @@
+package graphql
+
+import "encoding/json"
+
+// Optional is a value which may be absent, as distinct from null.  genqlient
+// uses it for fields with @skip or @include, if you set
+// use_optional_for_conditional_fields in genqlient.yaml: if the field was
+// skipped, it's absent from the response, so IsSet returns false; if it was
+// requested but null, IsSet returns true and the value is nil (or
+// otherwise zero).
+type Optional[T any] struct {
+	value T
+	set   bool
+}
+
+// NewOptional returns an Optional which is set to value.
+func NewOptional[T any](value T) Optional[T] {
+	return Optional[T]{value: value, set: true}
+}
+
+// IsSet returns whether the value is present (even if null).
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// Value returns the value, or the zero value of T if it's not set.
+func (o Optional[T]) Value() T {
+	return o.value
+}
+
+// Get returns the value, and whether it's set.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// MarshalJSON marshals the value, or null if it's not set.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON unmarshals the value, and marks it as set.  (If the key is
+// absent from the JSON object, UnmarshalJSON isn't called, so the value
+// remains unset.)
+func (o *Optional[T]) UnmarshalJSON(b []byte) error {
+	o.set = true
+	return json.Unmarshal(b, &o.value)
+}
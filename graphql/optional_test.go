@@ -0,0 +1,39 @@
+package graphql
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptional(t *testing.T) {
+	var v struct {
+		Skipped   Optional[*string] `json:"skipped"`
+		Null      Optional[*string] `json:"null"`
+		Requested Optional[*string] `json:"requested"`
+	}
+	err := json.Unmarshal([]byte(`{"null": null, "requested": "hi"}`), &v)
+	require.NoError(t, err)
+
+	assert.False(t, v.Skipped.IsSet())
+	assert.Nil(t, v.Skipped.Value())
+
+	value, ok := v.Null.Get()
+	assert.True(t, ok)
+	assert.Nil(t, value)
+
+	value, ok = v.Requested.Get()
+	assert.True(t, ok)
+	require.NotNil(t, value)
+	assert.Equal(t, "hi", *value)
+
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.Equal(t, `{"skipped":null,"null":null,"requested":"hi"}`, string(b))
+
+	b, err = json.Marshal(NewOptional(3))
+	require.NoError(t, err)
+	assert.Equal(t, `3`, string(b))
+}
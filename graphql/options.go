@@ -0,0 +1,355 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// A ClientOption configures a [Client] returned by [NewClient] or
+// [NewClientUsingGet].
+type ClientOption func(*client)
+
+// WithRequestModifier configures the client to call modify on each request
+// before sending it, for example to add a variable (such as a tenant ID) to
+// every request.  It's called before the HTTP request is built, so it applies
+// to GET requests and file uploads as well.  modify is passed a (shallow)
+// copy of the request, so it may set the request's fields without modifying
+// the caller's Request; values the request points to, such as its
+// variables, are shared with the caller.
+//
+// If passed several times, the modifiers are called in order.
+func WithRequestModifier(modify func(*Request)) ClientOption {
+	return func(c *client) {
+		c.requestModifiers = append(c.requestModifiers, modify)
+	}
+}
+
+// WithoutOperationName configures the client to omit the operation name from
+// the requests it sends, for servers which reject an operationName when the
+// query contains just one operation.  This is only safe if each query does
+// contain just one operation, as those generated by genqlient do.
+//
+// The operation name is still passed to [MetricsHook], if any.
+func WithoutOperationName() ClientOption {
+	return func(c *client) {
+		c.omitOperationName = true
+	}
+}
+
+// WithRequestFieldNames configures the client to send the query,
+// variables, and operation name of each request under the given names, for
+// servers which expect something other than the standard "query",
+// "variables", and "operationName".  The names apply to the JSON body of POST
+// requests (including the operations of file uploads), and to the parameters
+// of GET and form-encoded requests.  An empty name leaves that field's name
+// unchanged.
+func WithRequestFieldNames(query, variables, operationName string) ClientOption {
+	return func(c *client) {
+		if query != "" {
+			c.fieldNames.query = query
+		}
+		if variables != "" {
+			c.fieldNames.variables = variables
+		}
+		if operationName != "" {
+			c.fieldNames.operationName = operationName
+		}
+	}
+}
+
+// WithBearerToken configures the client to send the header
+// "Authorization: Bearer <token>" with each request, for the common case of
+// an API authenticated with a static token.  For other kinds of
+// authentication, wrap the [http.Client]'s transport instead; see
+// [NewClient].
+func WithBearerToken(token string) ClientOption {
+	return WithBearerTokenFunc(func() string { return token })
+}
+
+// WithBearerTokenFunc is like [WithBearerToken], but calls token to get the
+// token for each request, for tokens which rotate.  If token returns the
+// empty string, the request is sent without an Authorization header.
+func WithBearerTokenFunc(token func() string) ClientOption {
+	return func(c *client) {
+		c.bearerToken = token
+	}
+}
+
+// WithTraceparent configures the client to send the W3C Trace Context
+// "traceparent" header with each request, with the value returned by
+// traceparent for the request's context, for tracing without a full
+// tracing library.  If traceparent returns the empty string, the request is
+// sent without the header.  The value should be of the form
+//
+//	00-<trace-id>-<parent-id>-<trace-flags>
+//
+// see https://www.w3.org/TR/trace-context/#traceparent-header for details.
+func WithTraceparent(traceparent func(ctx context.Context) string) ClientOption {
+	return func(c *client) {
+		c.traceparent = traceparent
+	}
+}
+
+// WithErrorClassifier configures the client to classify the GraphQL errors
+// returned by the server, for example as authentication or not-found errors
+// based on their extensions.code.  classify is called with the errors in
+// each response which has any, and returns a (typically sentinel) error
+// describing them, or nil to leave them unclassified.
+//
+// MakeRequest then returns an error which wraps both the classification and
+// the original [gqlerror.List], so callers can check for either:
+//
+//	var ErrNotFound = errors.New("not found")
+//
+//	client := graphql.NewClient(url, nil, graphql.WithErrorClassifier(
+//		func(errs gqlerror.List) error {
+//			for _, err := range errs {
+//				if err.Extensions["code"] == "NOT_FOUND" {
+//					return ErrNotFound
+//				}
+//			}
+//			return nil
+//		}))
+//
+//	resp, err := MyQuery(ctx, client)
+//	if errors.Is(err, ErrNotFound) { ... }
+//
+// Errors which don't come from the GraphQL response, such as network errors
+// or non-200 statuses, are not classified.
+func WithErrorClassifier(classify func(gqlerror.List) error) ClientOption {
+	return func(c *client) {
+		c.classifyErrors = classify
+	}
+}
+
+// WithErrorWrapper configures the client to wrap each error MakeRequest
+// returns with wrap, which is called with the name of the operation (as set
+// by genqlient's generated functions) and the error, for example to add
+// context in a format a central error handler can parse:
+//
+//	client := graphql.NewClient(url, nil, graphql.WithErrorWrapper(
+//		func(opName string, err error) error {
+//			return fmt.Errorf("graphql operation %s: %w", opName, err)
+//		}))
+//
+// So that callers can still inspect the error, for example as a
+// [gqlerror.List], wrap should wrap err (e.g. with %w) rather than replace
+// it.
+// If wrap returns nil, the original error is returned.
+func WithErrorWrapper(wrap func(opName string, err error) error) ClientOption {
+	return func(c *client) {
+		c.wrapError = wrap
+	}
+}
+
+// WithResponseUnwrapper configures the client to call unwrap on the body of
+// each successful response, and decode the body it returns as the GraphQL
+// response.  This is useful for servers which wrap the standard GraphQL
+// response in some other envelope, for example
+//
+//	graphql.WithResponseUnwrapper(func(body []byte) ([]byte, error) {
+//		var envelope struct{ Result json.RawMessage }
+//		err := json.Unmarshal(body, &envelope)
+//		return envelope.Result, err
+//	})
+//
+// unwrap is called after the response is decompressed (see
+// [WithResponseDecompression]).  It's not called for non-200 responses, or
+// for requests using [WithRawResponse].  If it returns an error, MakeRequest
+// returns the error.
+func WithResponseUnwrapper(unwrap func(body []byte) ([]byte, error)) ClientOption {
+	return func(c *client) {
+		c.unwrapResponse = unwrap
+	}
+}
+
+// WithEndpointResolver configures the client to call resolve for each
+// request to determine the URL to which to send it, for example to route
+// requests to a canary deployment based on a value in ctx.  resolve is called
+// with the request's context and the request, after any request modifiers
+// (see [WithRequestModifier]), and applies to GET requests and file uploads
+// as well.  If it returns the empty string, the request is sent to the
+// endpoint it would be otherwise: the one with which the client was created,
+// or that passed to [WithEndpoint].
+//
+// If resolve returns an error, the request is not sent, and MakeRequest
+// returns the error.
+func WithEndpointResolver(resolve func(ctx context.Context, req *Request) (string, error)) ClientOption {
+	return func(c *client) {
+		c.resolveEndpoint = resolve
+	}
+}
+
+// WithMaxComplexity configures the client to refuse to send requests whose
+// complexity exceeds maxComplexity, to guard against accidentally expensive
+// queries.  MakeRequest then returns an error wrapping
+// [ErrComplexityExceeded].
+//
+// The complexity is that of [Request.Complexity], which genqlient sets if
+// the generate_complexity option is enabled; requests without a complexity
+// are always sent.  The check happens after any request modifiers (see
+// [WithRequestModifier]).
+func WithMaxComplexity(maxComplexity int) ClientOption {
+	return func(c *client) {
+		c.maxComplexity = maxComplexity
+	}
+}
+
+// WithDisallowUnknownFields configures the client to return an error if the
+// response data has fields which the response type doesn't, for example
+// because the server returned fields which weren't requested.  This is
+// useful for strict contract testing, but isn't recommended in production,
+// where it makes the client less tolerant of server changes.  The top-level
+// errors and extensions are never checked.
+//
+// The check uses [json.Decoder.DisallowUnknownFields], so it doesn't apply
+// within types with their own UnmarshalJSON method; these include the types
+// genqlient generates for interfaces and for fragment spreads, as well as
+// types with custom unmarshalers.
+func WithDisallowUnknownFields() ClientOption {
+	return func(c *client) {
+		c.disallowUnknown = true
+	}
+}
+
+// A RequestSigner computes a signature of the given request body, which will
+// be sent in the HTTP header headerKey.  See [WithRequestSigner].
+type RequestSigner func(body []byte) (headerKey, headerValue string, err error)
+
+// WithRequestSigner configures the client to sign each request, for APIs
+// which require (say) an HMAC of the request body in a header.  sign is called
+// with the exact HTTP request body, after it's fully built -- for file
+// uploads, the multipart body -- and the header it returns is added to the
+// request.  GET requests have no body, so sign is called with an empty body.
+//
+// If sign returns an error, the request is not sent, and MakeRequest returns
+// the error.
+func WithRequestSigner(sign RequestSigner) ClientOption {
+	return func(c *client) {
+		c.signer = sign
+	}
+}
+
+// signRequest signs req's body with sign, and adds the signature header.
+func signRequest(req *http.Request, sign RequestSigner) error {
+	var body []byte
+	if req.GetBody != nil {
+		// We always build the body in memory, so this is cheap (and leaves
+		// req.Body unconsumed).
+		bodyReader, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		body, err = io.ReadAll(bodyReader)
+		if err != nil {
+			return err
+		}
+	}
+
+	key, value, err := sign(body)
+	if err != nil {
+		return fmt.Errorf("signing request: %w", err)
+	}
+	req.Header.Set(key, value)
+	return nil
+}
+
+// An Option customizes a single request made by a [Client] returned by
+// [NewClient] or [NewClientUsingGet].
+//
+// Options may be attached to a request's context with [ContextWithOptions].
+// Custom [Client] implementations may ignore them.
+type Option func(*requestOptions)
+
+// requestOptions holds the settings configured by a list of Options.
+type requestOptions struct {
+	header      http.Header
+	timeout     time.Duration
+	endpoint    string
+	rawResponse *io.ReadCloser
+}
+
+// WithHeader sets the given HTTP header on the request, replacing any value
+// genqlient would otherwise set.
+func WithHeader(key, value string) Option {
+	return func(opts *requestOptions) {
+		if opts.header == nil {
+			opts.header = http.Header{}
+		}
+		opts.header.Set(key, value)
+	}
+}
+
+// WithTimeout sets a timeout for the request, including reading the response.
+// It has no effect if the request's context already has an earlier deadline.
+func WithTimeout(timeout time.Duration) Option {
+	return func(opts *requestOptions) {
+		opts.timeout = timeout
+	}
+}
+
+// WithEndpoint sends the request to the given URL, instead of the endpoint
+// with which the client was created.
+//
+// genqlient-generated functions use this to implement
+// `@genqlient(endpoint: ...)`.
+func WithEndpoint(endpoint string) Option {
+	return func(opts *requestOptions) {
+		opts.endpoint = endpoint
+	}
+}
+
+// WithRawResponse makes the request hand back the (decompressed) response
+// body, unparsed, in *body, rather than decoding it as JSON into the
+// [Response].  This is useful for download-style operations, for which the
+// server streams binary content rather than a GraphQL response.
+//
+// The caller must close *body when done with it.  If the server responds
+// with a non-200 status, *body is left unset and the request returns an error
+// as usual.  Any timeout set by [WithTimeout] applies until *body is closed.
+func WithRawResponse(body *io.ReadCloser) Option {
+	return func(opts *requestOptions) {
+		opts.rawResponse = body
+	}
+}
+
+type optionsContextKey struct{}
+
+// ContextWithOptions returns a copy of ctx which carries the given options,
+// in addition to any options already in ctx.  Requests made with the
+// returned context apply those options in order, so later options take
+// precedence.
+//
+// This allows customizing requests made by genqlient-generated functions,
+// which pass their context through to [Client.MakeRequest]:
+//
+//	ctx = graphql.ContextWithOptions(ctx, graphql.WithHeader("X-Request-Id", id))
+//	resp, err := getUser(ctx, client, "benjaminjkraft")
+func ContextWithOptions(ctx context.Context, opts ...Option) context.Context {
+	existing, _ := ctx.Value(optionsContextKey{}).([]Option)
+	// Copy, so that contexts derived from the same parent don't share (and
+	// clobber) a backing array.
+	all := make([]Option, 0, len(existing)+len(opts))
+	all = append(all, existing...)
+	all = append(all, opts...)
+	return context.WithValue(ctx, optionsContextKey{}, all)
+}
+
+// optionsFromContext returns the settings configured by the options in ctx
+// (which may be nil).
+func optionsFromContext(ctx context.Context) *requestOptions {
+	var retval requestOptions
+	if ctx == nil {
+		return &retval
+	}
+	opts, _ := ctx.Value(optionsContextKey{}).([]Option)
+	for _, opt := range opts {
+		opt(&retval)
+	}
+	return &retval
+}
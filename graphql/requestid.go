@@ -0,0 +1,95 @@
+package graphql
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+)
+
+// WithRequestID configures the client to send a unique ID with each request,
+// in the given header (typically "X-Request-ID"), so that the request can be
+// correlated across services.  The ID is a random (version 4) UUID, unless
+// the context passed to MakeRequest already has one, from
+// [ContextWithRequestID].
+//
+// The ID is also available to hooks such as [MetricsHook] via
+// [RequestIDFromContext], and any error MakeRequest returns (including
+// [ErrClientShutdown], but not a failure to generate the ID itself) is a
+// [*RequestIDError] which includes it.
+func WithRequestID(header string) ClientOption {
+	return func(c *client) {
+		c.requestIDHeader = header
+	}
+}
+
+// RequestIDError is the error returned by a client configured with
+// [WithRequestID] when a request fails.  It wraps the error the client would
+// otherwise return, so callers can still check for e.g. [ErrNoData] or a
+// [gqlerror.List].  For example:
+//
+//	var idErr *graphql.RequestIDError
+//	if errors.As(err, &idErr) {
+//		log.Printf("request %s failed: %v", idErr.RequestID, idErr.Err)
+//	}
+type RequestIDError struct {
+	RequestID string
+	Err       error
+}
+
+func (err *RequestIDError) Error() string {
+	// (gqlerror.List's message ends in a newline.)
+	return fmt.Sprintf("%v (request ID %s)", strings.TrimSuffix(err.Err.Error(), "\n"), err.RequestID)
+}
+
+func (err *RequestIDError) Unwrap() error {
+	return err.Err
+}
+
+type requestIDKey struct{}
+
+// ContextWithRequestID returns a context which makes a client configured
+// with [WithRequestID] send the given ID, rather than generating one.  This
+// is useful to propagate the ID of an incoming request to the requests made
+// while handling it.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID in ctx, or the empty string if
+// there is none.  Within a request made by a client configured with
+// [WithRequestID], for example in a [MetricsHook], it's that request's ID.
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// ensureRequestID returns ctx (or, if nil, a background context) with a
+// request ID, and the ID: the one already in ctx if any, or a new one.
+func ensureRequestID(ctx context.Context) (context.Context, string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if id := RequestIDFromContext(ctx); id != "" {
+		return ctx, id, nil
+	}
+	id, err := newRequestID()
+	if err != nil {
+		return nil, "", err
+	}
+	return ContextWithRequestID(ctx, id), id, nil
+}
+
+// newRequestID returns a random (version 4) UUID.
+func newRequestID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("generating request ID: %w", err)
+	}
+	b[6] = b[6]&0x0f | 0x40 // version 4
+	b[8] = b[8]&0x3f | 0x80 // variant 10
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
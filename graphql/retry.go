@@ -0,0 +1,132 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+// IdempotencyKeyHeader is the HTTP header which marks a mutation as safe to
+// retry; see [NewRetryingClient].
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// A RetryPolicy configures a client returned by [NewRetryingClient].
+type RetryPolicy struct {
+	// The maximum number of attempts to make, including the first; if it's
+	// less than 2, requests are never retried.
+	MaxAttempts int
+	// How long to wait before the first retry; the wait doubles before each
+	// subsequent retry.
+	Backoff time.Duration
+	// If set, a function which reports whether the given error, returned by
+	// the inner client, may be retried.  By default, only network errors
+	// and [HTTPError]s with status 429 (Too Many Requests) or 5xx are
+	// retried; other errors, such as GraphQL errors (in which case the
+	// server processed the request), other HTTP errors, errors building the
+	// request, and context cancellation, would likely just happen again.
+	Retryable func(error) bool
+}
+
+// retryingClient is the [Client] returned by [NewRetryingClient].
+type retryingClient struct {
+	inner  Client
+	policy RetryPolicy
+}
+
+// NewRetryingClient returns a [Client] which makes requests with inner, and
+// retries those which fail with a retryable error, according to policy.
+//
+// Only requests which are safe to retry are retried: queries, and mutations
+// sent with an idempotency key (the HTTP header [IdempotencyKeyHeader], set
+// via [WithHeader]), which the server may use to avoid applying the mutation
+// twice:
+//
+//	ctx = graphql.ContextWithOptions(ctx,
+//		graphql.WithHeader(graphql.IdempotencyKeyHeader, uuid.NewString()))
+//	resp, err := createUser(ctx, client, name)
+//
+// genqlient marks the requests for mutations it generates via
+// [Request.IsMutation]; other requests are considered mutations unless their
+// query starts with "query" or "{".  Requests with file uploads are never
+// retried, since the files can't be re-read.
+func NewRetryingClient(inner Client, policy RetryPolicy) Client {
+	if policy.Retryable == nil {
+		policy.Retryable = isRetryable
+	}
+	return &retryingClient{inner: inner, policy: policy}
+}
+
+func (c *retryingClient) Close() error {
+	return CloseClient(c.inner)
+}
+
+func (c *retryingClient) Shutdown(ctx context.Context) error {
+	return ShutdownClient(ctx, c.inner)
+}
+
+func (c *retryingClient) MakeRequest(ctx context.Context, req *Request, resp *Response) error {
+	err := c.inner.MakeRequest(ctx, req, resp)
+	if err == nil || !c.mayRetry(ctx, req) {
+		return err
+	}
+
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+	wait := c.policy.Backoff
+	for attempt := 1; attempt < c.policy.MaxAttempts && c.policy.Retryable(err); attempt++ {
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-done:
+			timer.Stop()
+			return err
+		}
+		wait *= 2
+
+		if resp != nil {
+			resp.Errors, resp.Extensions = nil, nil
+		}
+		err = c.inner.MakeRequest(ctx, req, resp)
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// mayRetry returns whether req is safe to retry.
+func (c *retryingClient) mayRetry(ctx context.Context, req *Request) bool {
+	if req.IsMutation || !isQuery(req.Query) {
+		header := optionsFromContext(ctx).header
+		if header == nil || header.Get(IdempotencyKeyHeader) == "" {
+			return false
+		}
+	}
+	if req.Variables != nil {
+		files, err := findVariablesFiles("variables", req.Variables)
+		if err != nil || len(files) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isRetryable is the default RetryPolicy.Retryable.
+func isRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests || httpErr.StatusCode >= 500
+	}
+	// Network errors include the *url.Error returned by http.Client.Do; a
+	// connection dropped mid-response is an unexpected EOF.
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,128 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// flakyClient is a Client which fails the first failures requests it
+// receives with err.
+type flakyClient struct {
+	calls    int
+	failures int
+	err      error
+}
+
+func (c *flakyClient) MakeRequest(ctx context.Context, req *Request, resp *Response) error {
+	c.calls++
+	if c.calls <= c.failures {
+		return c.err
+	}
+	return nil
+}
+
+// errTransient is a network error, as the inner client might return.
+var errTransient error = &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset")}
+
+func TestRetryingClient(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3}
+	query := &Request{OpName: "q", Query: "query q { f }"}
+	mutation := &Request{OpName: "m", Query: "mutation m { f }", IsMutation: true}
+	keyCtx := ContextWithOptions(context.Background(),
+		WithHeader(IdempotencyKeyHeader, "abc"))
+
+	for _, test := range []struct {
+		name      string
+		ctx       context.Context
+		req       *Request
+		failures  int
+		err       error
+		wantCalls int
+		wantErr   bool
+	}{
+		{"QuerySucceeds", context.Background(), query, 0, errTransient, 1, false},
+		{"QueryRetried", context.Background(), query, 2, errTransient, 3, false},
+		{"QueryGivesUp", context.Background(), query, 5, errTransient, 3, true},
+		{"GraphQLErrorNotRetried", context.Background(), query, 1, gqlerror.List{{Message: "bad"}}, 1, true},
+		{"ServerErrorRetried", context.Background(), query, 1, &HTTPError{StatusCode: 503}, 2, false},
+		{"TooManyRequestsRetried", context.Background(), query, 1, &HTTPError{StatusCode: 429}, 2, false},
+		{"BadRequestNotRetried", context.Background(), query, 1, &HTTPError{StatusCode: 400}, 1, true},
+		{"RequestErrorNotRetried", context.Background(), query, 1, errors.New("signing request: no key"), 1, true},
+		{"ComplexityNotRetried", context.Background(), query, 1, ErrComplexityExceeded, 1, true},
+		{"MutationNotRetried", context.Background(), mutation, 1, errTransient, 1, true},
+		{"MutationWithKeyRetried", keyCtx, mutation, 1, errTransient, 2, false},
+		{"UnmarkedMutationNotRetried", context.Background(),
+			&Request{OpName: "m", Query: "mutation m { f }"}, 1, errTransient, 1, true},
+		{"UploadNotRetried", context.Background(), &Request{
+			OpName:    "q",
+			Query:     "query q($file: Upload!) { f(file: $file) }",
+			Variables: Vars{"file": Upload{FileName: "a.txt", Body: strings.NewReader("hi")}},
+		}, 1, errTransient, 1, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			inner := &flakyClient{failures: test.failures, err: test.err}
+			err := NewRetryingClient(inner, policy).MakeRequest(test.ctx, test.req, &Response{})
+			assert.Equal(t, test.wantCalls, inner.calls)
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRetryingClientWithClient(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3}
+	req := &Request{OpName: "q", Query: "query q { f }"}
+
+	for _, test := range []struct {
+		name      string
+		status    int
+		signErr   error
+		wantCalls int
+	}{
+		{"ServerError", http.StatusServiceUnavailable, nil, 3},
+		{"BadRequest", http.StatusBadRequest, nil, 1},
+		{"SignerError", http.StatusOK, errors.New("no key"), 1},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+			})
+			// The signer is called once per attempt, even if it fails.
+			calls := 0
+			inner := NewClient(server.URL, nil, WithRequestSigner(
+				func(body []byte) (string, string, error) {
+					calls++
+					return "X-Signature", "sig", test.signErr
+				}))
+			err := NewRetryingClient(inner, policy).MakeRequest(
+				context.Background(), req, &Response{})
+			assert.Error(t, err)
+			assert.Equal(t, test.wantCalls, calls)
+		})
+	}
+
+	t.Run("NetworkError", func(t *testing.T) {
+		server := newTestServer(t, nil)
+		server.Close()
+		calls := 0
+		inner := NewClient(server.URL, nil, WithRequestSigner(
+			func(body []byte) (string, string, error) {
+				calls++
+				return "X-Signature", "sig", nil
+			}))
+		err := NewRetryingClient(inner, policy).MakeRequest(
+			context.Background(), req, &Response{})
+		assert.Error(t, err)
+		assert.Equal(t, 3, calls)
+	})
+}
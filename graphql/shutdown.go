@@ -0,0 +1,89 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+)
+
+// Shutdowner is implemented by [Client] implementations which can wait for
+// their in-flight requests to finish, for graceful shutdown of a service.
+//
+// Generated code never calls Shutdown; it's up to the owner of the client to
+// do so, typically via [ShutdownClient].
+type Shutdowner interface {
+	Client
+	// Shutdown stops the client from accepting new requests, which fail with
+	// [ErrClientShutdown], and waits for in-flight requests to finish.  If
+	// ctx is done first, Shutdown returns its error; the in-flight requests
+	// continue (to cancel them, cancel their contexts).
+	Shutdown(ctx context.Context) error
+}
+
+// ShutdownClient shuts down the given client, if it implements
+// [Shutdowner], and is a no-op otherwise.  The clients returned by
+// [NewClient] and [NewClientUsingGet] implement Shutdowner, as do the
+// wrapper clients in this package (which shut down the client they wrap).
+//
+// For example, to drain requests before exiting:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//	defer cancel()
+//	if err := graphql.ShutdownClient(ctx, client); err != nil {
+//		log.Printf("requests still in flight: %v", err)
+//	}
+func ShutdownClient(ctx context.Context, c Client) error {
+	if shutdowner, ok := c.(Shutdowner); ok {
+		return shutdowner.Shutdown(ctx)
+	}
+	return nil
+}
+
+// inFlightTracker tracks the requests a client is making, so that Shutdown
+// can wait for them.
+type inFlightTracker struct {
+	mu       sync.Mutex
+	shutdown bool
+	wg       sync.WaitGroup
+}
+
+// start records the start of a request, and returns false if the client is
+// shut down, in which case the request should not be made.  Otherwise,
+// the caller must call finish when the request is done.
+func (t *inFlightTracker) start() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.shutdown {
+		return false
+	}
+	t.wg.Add(1)
+	return true
+}
+
+func (t *inFlightTracker) finish() {
+	t.wg.Done()
+}
+
+func (t *inFlightTracker) wait(ctx context.Context) error {
+	t.mu.Lock()
+	t.shutdown = true
+	t.mu.Unlock()
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	done := make(chan struct{})
+	go func() {
+		t.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *client) Shutdown(ctx context.Context) error {
+	return c.inFlight.wait(ctx)
+}
@@ -0,0 +1,162 @@
+package graphql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// singleflightClient is the [Client] returned by [NewSingleflightClient].
+type singleflightClient struct {
+	inner Client
+
+	mu       sync.Mutex
+	inFlight map[string]*flight
+}
+
+// flight is a single request made on behalf of one or more callers.
+type flight struct {
+	done       chan struct{} // closed once the fields below are set
+	data       json.RawMessage
+	extensions map[string]interface{}
+	errors     gqlerror.List
+	err        error
+}
+
+// NewSingleflightClient returns a [Client] which coalesces concurrent
+// identical queries made through it into a single request, made with inner,
+// whose response it shares with each caller.  This reduces load on hot read
+// paths, where many goroutines may make the same query at once.
+//
+// Two requests are identical if they have the same query, operation name,
+// and variables (compared by their JSON encoding), and the same endpoint (see
+// [WithEndpoint]).  Mutations, requests with file uploads or a VariablesFunc,
+// requests with per-request headers or [WithRawResponse], and those with a
+// custom response type (see [Response.Envelope]), are never coalesced.
+//
+// The shared request is made with the values of the first caller's context,
+// but is not canceled with it: a caller whose context is canceled (or times
+// out) gets that context's error, while the request continues for the others.
+// Each caller gets its own copy of the response data, and the same error, if
+// any.
+func NewSingleflightClient(inner Client) Client {
+	return &singleflightClient{inner: inner, inFlight: map[string]*flight{}}
+}
+
+func (c *singleflightClient) Close() error {
+	return CloseClient(c.inner)
+}
+
+func (c *singleflightClient) Shutdown(ctx context.Context) error {
+	return ShutdownClient(ctx, c.inner)
+}
+
+func (c *singleflightClient) MakeRequest(ctx context.Context, req *Request, resp *Response) error {
+	key, ok := singleflightKey(ctx, req, resp)
+	if !ok {
+		return c.inner.MakeRequest(ctx, req, resp)
+	}
+
+	c.mu.Lock()
+	f, ok := c.inFlight[key]
+	if !ok {
+		f = &flight{done: make(chan struct{})}
+		c.inFlight[key] = f
+		// The request is shared, so no one caller's cancellation should
+		// cancel it; each caller instead stops waiting on its own.
+		var runCtx context.Context
+		if ctx != nil {
+			runCtx = withoutCancel{ctx}
+		}
+		go c.run(runCtx, key, req, f)
+	}
+	c.mu.Unlock()
+
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+	select {
+	case <-f.done:
+	case <-done:
+		return ctx.Err()
+	}
+
+	if resp == nil {
+		return f.err
+	}
+	resp.Extensions = f.extensions
+	resp.Errors = f.errors
+	if len(f.data) > 0 && resp.Data != nil {
+		if err := json.Unmarshal(f.data, resp.Data); err != nil {
+			return err
+		}
+	}
+	return f.err
+}
+
+// run makes the request for f, and removes it from c.inFlight once done, so
+// that later requests are made afresh.
+func (c *singleflightClient) run(ctx context.Context, key string, req *Request, f *flight) {
+	defer func() {
+		c.mu.Lock()
+		delete(c.inFlight, key)
+		c.mu.Unlock()
+		close(f.done)
+	}()
+
+	var data json.RawMessage
+	resp := &Response{Data: &data}
+	f.err = c.inner.MakeRequest(ctx, req, resp)
+	f.data = data
+	f.extensions = resp.Extensions
+	f.errors = resp.Errors
+}
+
+// singleflightKey returns the key by which to coalesce req, and whether it
+// may be coalesced at all.  (It's also the key by which [NewCachingClient]
+// caches responses.)
+func singleflightKey(ctx context.Context, req *Request, resp *Response) (string, bool) {
+	if req.VariablesFunc != nil || !isQuery(req.Query) {
+		return "", false
+	}
+	// We share just the data, extensions, and errors, so a custom envelope's
+	// other fields would go unset.
+	if resp != nil && resp.Envelope != nil {
+		return "", false
+	}
+	opts := optionsFromContext(ctx)
+	if opts.header != nil || opts.rawResponse != nil {
+		return "", false
+	}
+	if req.Variables != nil {
+		// (findFiles returns an error for unsupported variables, which we'll
+		// leave to the inner client to report.)
+		files, err := findVariablesFiles("variables", req.Variables)
+		if err != nil || len(files) > 0 {
+			return "", false
+		}
+	}
+
+	// Encoding the parts together as JSON separates them unambiguously.
+	encoded, err := json.Marshal([]interface{}{
+		opts.endpoint, req.OpName, req.Query, req.Variables,
+	})
+	if err != nil {
+		return "", false
+	}
+	hash := sha256.Sum256(encoded)
+	return hex.EncodeToString(hash[:]), true
+}
+
+// isQuery returns true if the given GraphQL document is (or at least, begins
+// with) a query, rather than a mutation or subscription.
+func isQuery(query string) bool {
+	query = strings.TrimSpace(query)
+	return strings.HasPrefix(query, "query") || strings.HasPrefix(query, "{")
+}
@@ -0,0 +1,183 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// blockingClient is a Client which responds to each request, once release is
+// closed, with data counting the requests it has received (or with the
+// request's context's error, if it has been canceled by then).
+type blockingClient struct {
+	calls   int32
+	release chan struct{}
+	err     error
+}
+
+func (c *blockingClient) MakeRequest(ctx context.Context, req *Request, resp *Response) error {
+	n := atomic.AddInt32(&c.calls, 1)
+	<-c.release
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	err := json.Unmarshal([]byte(`{"n": `+strconv.Itoa(int(n))+`}`), resp.Data)
+	if err != nil {
+		return err
+	}
+	resp.Extensions = map[string]interface{}{"n": n}
+	if c.err != nil {
+		resp.Errors = gqlerror.List{{Message: c.err.Error()}}
+		return c.err
+	}
+	return nil
+}
+
+type countData struct{ N int }
+
+// makeConcurrently makes the given requests with client, each in its own
+// goroutine, and returns the results once inner has been released.
+func makeConcurrently(
+	client Client,
+	inner *blockingClient,
+	reqs ...*Request,
+) ([]countData, []error) {
+	data := make([]countData, len(reqs))
+	errs := make([]error, len(reqs))
+	var wg sync.WaitGroup
+	for i, req := range reqs {
+		wg.Add(1)
+		go func(i int, req *Request) {
+			defer wg.Done()
+			errs[i] = client.MakeRequest(context.Background(), req, &Response{Data: &data[i]})
+		}(i, req)
+	}
+	// Give the requests time to start, then let them finish.
+	time.Sleep(50 * time.Millisecond)
+	close(inner.release)
+	wg.Wait()
+	return data, errs
+}
+
+func TestSingleflightClient(t *testing.T) {
+	inner := &blockingClient{release: make(chan struct{})}
+	client := NewSingleflightClient(inner)
+
+	query := func(id string) *Request {
+		return &Request{
+			Query:     "query q($id: ID!) { user(id: $id) { name } }",
+			OpName:    "q",
+			Variables: map[string]string{"id": id},
+		}
+	}
+	mutation := &Request{Query: "mutation m { f }", OpName: "m"}
+
+	data, errs := makeConcurrently(client, inner,
+		query("1"), query("1"), query("1"), query("2"), mutation, mutation)
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	// The three identical queries share a request; the others each get one.
+	assert.EqualValues(t, 4, inner.calls)
+	assert.Equal(t, data[0], data[1])
+	assert.Equal(t, data[0], data[2])
+	assert.NotEqual(t, data[0], data[3])
+	assert.NotEqual(t, data[4], data[5])
+
+	// Once the request is done, the next one is made afresh.
+	var again countData
+	err := client.MakeRequest(context.Background(), query("1"), &Response{Data: &again})
+	require.NoError(t, err)
+	assert.Equal(t, 5, again.N)
+}
+
+func TestSingleflightClientError(t *testing.T) {
+	errBoom := errors.New("boom")
+	inner := &blockingClient{release: make(chan struct{}), err: errBoom}
+	client := NewSingleflightClient(inner)
+
+	req := &Request{Query: "query q { f }", OpName: "q"}
+	data, errs := makeConcurrently(client, inner, req, req)
+	assert.EqualValues(t, 1, inner.calls)
+	for i := range errs {
+		assert.ErrorIs(t, errs[i], errBoom)
+		assert.Equal(t, 1, data[i].N)
+	}
+}
+
+func TestSingleflightClientFirstCallerCanceled(t *testing.T) {
+	inner := &blockingClient{release: make(chan struct{})}
+	client := NewSingleflightClient(inner)
+	req := &Request{Query: "query q { f }", OpName: "q"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error)
+	go func() {
+		var data countData
+		firstErr <- client.MakeRequest(ctx, req, &Response{Data: &data})
+	}()
+	// Give the first request time to start, so that it's the one which makes
+	// the shared request.
+	time.Sleep(50 * time.Millisecond)
+
+	var data countData
+	secondErr := make(chan error)
+	go func() {
+		secondErr <- client.MakeRequest(context.Background(), req, &Response{Data: &data})
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// The first caller stops waiting as soon as it's canceled, but the
+	// request continues for the second.
+	cancel()
+	assert.ErrorIs(t, <-firstErr, context.Canceled)
+	close(inner.release)
+	require.NoError(t, <-secondErr)
+	assert.Equal(t, 1, data.N)
+	assert.EqualValues(t, 1, inner.calls)
+}
+
+// envelopeClient is a Client which responds to each request with data and
+// a top-level "cost", decoded into the response's Envelope if set, as
+// NewClient does.
+type envelopeClient struct{ calls int }
+
+type costEnvelope struct {
+	Response
+	Cost int `json:"cost"`
+}
+
+func (c *envelopeClient) MakeRequest(ctx context.Context, req *Request, resp *Response) error {
+	c.calls++
+	var target interface{} = resp
+	if resp.Envelope != nil {
+		target = resp.Envelope
+	}
+	return json.Unmarshal([]byte(`{"data": {"n": `+strconv.Itoa(c.calls)+`}, "cost": 7}`), target)
+}
+
+// makeEnvelopeRequest makes req with client, with a custom envelope, and
+// returns the envelope.
+func makeEnvelopeRequest(t *testing.T, client Client, req *Request) *costEnvelope {
+	var data countData
+	resp := &costEnvelope{Response: Response{Data: &data}}
+	resp.Envelope = resp
+	require.NoError(t, client.MakeRequest(context.Background(), req, &resp.Response))
+	return resp
+}
+
+func TestSingleflightClientEnvelope(t *testing.T) {
+	client := NewSingleflightClient(&envelopeClient{})
+	resp := makeEnvelopeRequest(t, client, &Request{Query: "query q { n }", OpName: "q"})
+	assert.Equal(t, 7, resp.Cost)
+	assert.Equal(t, 1, resp.Data.(*countData).N)
+}
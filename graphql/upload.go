@@ -1,10 +1,163 @@
 package graphql
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"io"
+	"os"
+	"reflect"
+	"strings"
 )
 
 type Upload struct {
 	FileName string
 	Body     io.Reader
+	// Size is the length of Body in bytes, if known.  It's only used to
+	// decide whether to upload the file resumably (see
+	// [WithResumableUploads]); if it's zero, the size is taken from Body if
+	// it has a Len or Stat method (as do *bytes.Reader, *strings.Reader, and
+	// *os.File), and otherwise is considered unknown.
+	Size int64
+}
+
+// size returns the size of the upload, or -1 if it's unknown.
+func (u Upload) size() int64 {
+	if u.Size > 0 {
+		return u.Size
+	}
+	switch body := u.Body.(type) {
+	case interface{ Len() int }:
+		return int64(body.Len())
+	case interface{ Stat() (os.FileInfo, error) }:
+		info, err := body.Stat()
+		if err == nil && info.Mode().IsRegular() {
+			return info.Size()
+		}
+	}
+	return -1
+}
+
+// An UploadLister is a variables type which lists its own [Upload] values,
+// so that the client needn't find them via reflection, which is slow or
+// limited in some environments, such as TinyGo.  genqlient generates
+// implementations on its variables types if generate_upload_listers is set
+// in genqlient.yaml.
+type UploadLister interface {
+	// ListUploads calls add with each Upload in the value, and its path: the
+	// given path followed by the JSON keys and list indices leading to the
+	// Upload, each preceded by ".", for example "variables.input.files.0".
+	ListUploads(path string, add func(path string, file Upload))
+}
+
+// findVariablesFiles returns the files in variables, whose path is
+// parentKey: via [UploadLister] if implemented, or else via reflection.
+func findVariablesFiles(parentKey string, variables interface{}) ([]*fileVariable, error) {
+	lister, ok := variables.(UploadLister)
+	if !ok {
+		return findFiles(parentKey, reflect.ValueOf(variables), 0)
+	}
+
+	fileVariables := []*fileVariable{}
+	var err error
+	lister.ListUploads(parentKey, func(path string, file Upload) {
+		if file.Body == nil {
+			err = errors.New("Upload file body cannot be nil")
+		}
+		fileVariables = append(fileVariables, &fileVariable{mapKey: path, file: file})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fileVariables, nil
+}
+
+// A ResumableUploadFunc uploads a file out-of-band, typically using a
+// resumable protocol such as tus (https://tus.io), and returns the value to
+// send in its place in the GraphQL variables, such as the URL or ID of the
+// uploaded file.  The returned value must be JSON-marshalable.  See
+// [WithResumableUploads].
+type ResumableUploadFunc func(ctx context.Context, file Upload) (ref interface{}, err error)
+
+// WithResumableUploads configures the client to upload each file of at least
+// threshold bytes with upload, rather than in the multipart GraphQL request,
+// for very large files which are better uploaded resumably.  upload is called
+// before the GraphQL request is built (with the request's context), and the
+// reference it returns is sent in the variables in place of the file; your
+// schema must accept it there.  Smaller files, and files whose size is
+// unknown (see [Upload.Size]), are sent in the multipart request as usual.
+//
+// If upload returns an error, the GraphQL request is not sent, and
+// MakeRequest returns the error.
+func WithResumableUploads(threshold int64, upload ResumableUploadFunc) ClientOption {
+	return func(c *client) {
+		c.resumableUploadThreshold = threshold
+		c.resumableUpload = upload
+	}
+}
+
+// WithExtraFormField configures the client to send an additional field,
+// with the given name and value, in the multipart body of each request with
+// file uploads, for servers which require one (such as a CSRF token)
+// alongside the standard "operations" and "map" fields.  The extra fields
+// are written after "map", and before the files.
+//
+// If passed several times, each field is sent, in order.
+func WithExtraFormField(name, value string) ClientOption {
+	return func(c *client) {
+		c.extraFormFields = append(c.extraFormFields, formField{name, value})
+	}
+}
+
+// formField is a field of a multipart form; see [WithExtraFormField].
+type formField struct {
+	name, value string
+}
+
+// uploadResumably uploads those of fileVariables large enough to be uploaded
+// with c.resumableUpload, and returns a copy of req with them replaced by the
+// references the uploads returned, along with the files still to be sent in
+// the request.
+func (c *client) uploadResumably(
+	ctx context.Context,
+	req *Request,
+	fileVariables []*fileVariable,
+) (*Request, []*fileVariable, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var variables interface{}
+	var remaining []*fileVariable
+	for _, file := range fileVariables {
+		size := file.file.size()
+		if size < 0 || size < c.resumableUploadThreshold {
+			remaining = append(remaining, file)
+			continue
+		}
+
+		ref, err := c.resumableUpload(ctx, file.file)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error uploading file %s: %w", file.file.FileName, err)
+		}
+
+		if variables == nil {
+			variables, err = decodeVariables(req.Variables)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		path := strings.Split(strings.TrimPrefix(file.mapKey, "variables."), ".")
+		variables = replaceAtPath(variables, path, ref)
+	}
+
+	if variables == nil { // nothing to upload resumably
+		return req, fileVariables, nil
+	}
+
+	// The remaining files' keys are unchanged, since we replaced only the
+	// uploads themselves.  Copy, so as not to modify the caller's request.
+	reqCopy := *req
+	reqCopy.Variables = variables
+	return &reqCopy, remaining, nil
 }
@@ -605,6 +605,18 @@ type NewUser struct {
 // GetName returns NewUser.Name, and is useful for accessing the field via an interface.
 func (v *NewUser) GetName() string { return v.Name }
 
+// OptionalUserFields includes the GraphQL fields of User requested by the fragment OptionalUserFields.
+type OptionalUserFields struct {
+	Name        string `json:"name"`
+	LuckyNumber int    `json:"luckyNumber"`
+}
+
+// GetName returns OptionalUserFields.Name, and is useful for accessing the field via an interface.
+func (v *OptionalUserFields) GetName() string { return v.Name }
+
+// GetLuckyNumber returns OptionalUserFields.LuckyNumber, and is useful for accessing the field via an interface.
+func (v *OptionalUserFields) GetLuckyNumber() int { return v.LuckyNumber }
+
 // QueryFragment includes the GraphQL fields of Query requested by the fragment QueryFragment.
 type QueryFragment struct {
 	Beings []QueryFragmentBeingsBeing `json:"-"`
@@ -1006,6 +1018,14 @@ type __createUserInput struct {
 // GetUser returns __createUserInput.User, and is useful for accessing the field via an interface.
 func (v *__createUserInput) GetUser() NewUser { return v.User }
 
+// __queryNullScalarInput is used internally by genqlient
+type __queryNullScalarInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __queryNullScalarInput.Id, and is useful for accessing the field via an interface.
+func (v *__queryNullScalarInput) GetId() string { return v.Id }
+
 // __queryWithCustomMarshalInput is used internally by genqlient
 type __queryWithCustomMarshalInput struct {
 	Date time.Time `json:"-"`
@@ -1297,6 +1317,14 @@ type __queryWithOmitemptyInput struct {
 // GetId returns __queryWithOmitemptyInput.Id, and is useful for accessing the field via an interface.
 func (v *__queryWithOmitemptyInput) GetId() string { return v.Id }
 
+// __queryWithOptionalFragmentInput is used internally by genqlient
+type __queryWithOptionalFragmentInput struct {
+	IncludeFields bool `json:"includeFields"`
+}
+
+// GetIncludeFields returns __queryWithOptionalFragmentInput.IncludeFields, and is useful for accessing the field via an interface.
+func (v *__queryWithOptionalFragmentInput) GetIncludeFields() bool { return v.IncludeFields }
+
 // __queryWithVariablesInput is used internally by genqlient
 type __queryWithVariablesInput struct {
 	Id string `json:"id"`
@@ -1345,6 +1373,92 @@ func (v *failingQueryResponse) GetFail() bool { return v.Fail }
 // GetMe returns failingQueryResponse.Me, and is useful for accessing the field via an interface.
 func (v *failingQueryResponse) GetMe() failingQueryMeUser { return v.Me }
 
+// queryNullScalarResponse is returned by queryNullScalar on success.
+type queryNullScalarResponse struct {
+	User queryNullScalarUser `json:"user"`
+}
+
+// GetUser returns queryNullScalarResponse.User, and is useful for accessing the field via an interface.
+func (v *queryNullScalarResponse) GetUser() queryNullScalarUser { return v.User }
+
+// queryNullScalarUser includes the requested fields of the GraphQL type User.
+type queryNullScalarUser struct {
+	Id          string        `json:"id"`
+	GreatScalar MyGreatScalar `json:"-"`
+}
+
+// GetId returns queryNullScalarUser.Id, and is useful for accessing the field via an interface.
+func (v *queryNullScalarUser) GetId() string { return v.Id }
+
+// GetGreatScalar returns queryNullScalarUser.GreatScalar, and is useful for accessing the field via an interface.
+func (v *queryNullScalarUser) GetGreatScalar() MyGreatScalar { return v.GreatScalar }
+
+func (v *queryNullScalarUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*queryNullScalarUser
+		GreatScalar json.RawMessage `json:"greatScalar"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.queryNullScalarUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.GreatScalar
+		src := firstPass.GreatScalar
+		if len(src) != 0 && string(src) != "null" {
+			err = json.Unmarshal(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal queryNullScalarUser.GreatScalar: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalqueryNullScalarUser struct {
+	Id string `json:"id"`
+
+	GreatScalar json.RawMessage `json:"greatScalar"`
+}
+
+func (v *queryNullScalarUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *queryNullScalarUser) __premarshalJSON() (*__premarshalqueryNullScalarUser, error) {
+	var retval __premarshalqueryNullScalarUser
+
+	retval.Id = v.Id
+	{
+
+		dst := &retval.GreatScalar
+		src := v.GreatScalar
+		var err error
+		*dst, err = json.Marshal(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal queryNullScalarUser.GreatScalar: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
 // queryWithCustomMarshalOptionalResponse is returned by queryWithCustomMarshalOptional on success.
 type queryWithCustomMarshalOptionalResponse struct {
 	UserSearch []queryWithCustomMarshalOptionalUserSearchUser `json:"userSearch"`
@@ -3006,6 +3120,111 @@ func (v *queryWithOmitemptyUser) GetName() string { return v.Name }
 // GetLuckyNumber returns queryWithOmitemptyUser.LuckyNumber, and is useful for accessing the field via an interface.
 func (v *queryWithOmitemptyUser) GetLuckyNumber() int { return v.LuckyNumber }
 
+// queryWithOptionalFragmentMeUser includes the requested fields of the GraphQL type User.
+type queryWithOptionalFragmentMeUser struct {
+	Id                  string `json:"id"`
+	*OptionalUserFields `json:"-"`
+}
+
+// GetId returns queryWithOptionalFragmentMeUser.Id, and is useful for accessing the field via an interface.
+func (v *queryWithOptionalFragmentMeUser) GetId() string { return v.Id }
+
+// GetName returns queryWithOptionalFragmentMeUser.Name, or the zero value if the optional fragment OptionalUserFields was not included.
+func (v *queryWithOptionalFragmentMeUser) GetName() (retval string) {
+	if v.OptionalUserFields != nil {
+		retval = v.OptionalUserFields.Name
+	}
+	return retval
+}
+
+// GetLuckyNumber returns queryWithOptionalFragmentMeUser.LuckyNumber, or the zero value if the optional fragment OptionalUserFields was not included.
+func (v *queryWithOptionalFragmentMeUser) GetLuckyNumber() (retval int) {
+	if v.OptionalUserFields != nil {
+		retval = v.OptionalUserFields.LuckyNumber
+	}
+	return retval
+}
+
+func (v *queryWithOptionalFragmentMeUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*queryWithOptionalFragmentMeUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.queryWithOptionalFragmentMeUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	var present map[string]json.RawMessage
+	err = json.Unmarshal(b, &present)
+	if err != nil {
+		return err
+	}
+
+	if present["name"] != nil || present["luckyNumber"] != nil {
+		v.OptionalUserFields = new(OptionalUserFields)
+		err = json.Unmarshal(
+			b, v.OptionalUserFields)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type __premarshalqueryWithOptionalFragmentMeUser struct {
+	Id string `json:"id"`
+
+	Name json.RawMessage `json:"name,omitempty"`
+
+	LuckyNumber json.RawMessage `json:"luckyNumber,omitempty"`
+}
+
+func (v *queryWithOptionalFragmentMeUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *queryWithOptionalFragmentMeUser) __premarshalJSON() (*__premarshalqueryWithOptionalFragmentMeUser, error) {
+	var retval __premarshalqueryWithOptionalFragmentMeUser
+
+	retval.Id = v.Id
+	if v.OptionalUserFields != nil {
+		b, err := json.Marshal(v.OptionalUserFields)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal queryWithOptionalFragmentMeUser.OptionalUserFields: %w", err)
+		}
+		var fields map[string]json.RawMessage
+		err = json.Unmarshal(b, &fields)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal queryWithOptionalFragmentMeUser.OptionalUserFields: %w", err)
+		}
+		retval.Name = fields["name"]
+		retval.LuckyNumber = fields["luckyNumber"]
+	}
+	return &retval, nil
+}
+
+// queryWithOptionalFragmentResponse is returned by queryWithOptionalFragment on success.
+type queryWithOptionalFragmentResponse struct {
+	Me queryWithOptionalFragmentMeUser `json:"me"`
+}
+
+// GetMe returns queryWithOptionalFragmentResponse.Me, and is useful for accessing the field via an interface.
+func (v *queryWithOptionalFragmentResponse) GetMe() queryWithOptionalFragmentMeUser { return v.Me }
+
 // queryWithVariablesResponse is returned by queryWithVariables on success.
 type queryWithVariablesResponse struct {
 	User queryWithVariablesUser `json:"user"`
@@ -3059,7 +3278,7 @@ type simpleQueryMeUser struct {
 	Id          string        `json:"id"`
 	Name        string        `json:"name"`
 	LuckyNumber int           `json:"luckyNumber"`
-	GreatScalar MyGreatScalar `json:"greatScalar"`
+	GreatScalar MyGreatScalar `json:"-"`
 }
 
 // GetId returns simpleQueryMeUser.Id, and is useful for accessing the field via an interface.
@@ -3074,6 +3293,78 @@ func (v *simpleQueryMeUser) GetLuckyNumber() int { return v.LuckyNumber }
 // GetGreatScalar returns simpleQueryMeUser.GreatScalar, and is useful for accessing the field via an interface.
 func (v *simpleQueryMeUser) GetGreatScalar() MyGreatScalar { return v.GreatScalar }
 
+func (v *simpleQueryMeUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*simpleQueryMeUser
+		GreatScalar json.RawMessage `json:"greatScalar"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.simpleQueryMeUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.GreatScalar
+		src := firstPass.GreatScalar
+		if len(src) != 0 && string(src) != "null" {
+			err = json.Unmarshal(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal simpleQueryMeUser.GreatScalar: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalsimpleQueryMeUser struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	LuckyNumber int `json:"luckyNumber"`
+
+	GreatScalar json.RawMessage `json:"greatScalar"`
+}
+
+func (v *simpleQueryMeUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *simpleQueryMeUser) __premarshalJSON() (*__premarshalsimpleQueryMeUser, error) {
+	var retval __premarshalsimpleQueryMeUser
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.LuckyNumber = v.LuckyNumber
+	{
+
+		dst := &retval.GreatScalar
+		src := v.GreatScalar
+		var err error
+		*dst, err = json.Marshal(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal simpleQueryMeUser.GreatScalar: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
 // simpleQueryResponse is returned by simpleQuery on success.
 type simpleQueryResponse struct {
 	Me simpleQueryMeUser `json:"me"`
@@ -3098,8 +3389,9 @@ func createUser(
 	user NewUser,
 ) (*createUserResponse, map[string]interface{}, error) {
 	req_ := &graphql.Request{
-		OpName: "createUser",
-		Query:  createUser_Operation,
+		OpName:     "createUser",
+		Query:      createUser_Operation,
+		IsMutation: true,
 		Variables: &__createUserInput{
 			User: user,
 		},
@@ -3150,6 +3442,42 @@ func failingQuery(
 	return &data_, resp_.Extensions, err_
 }
 
+// The query or mutation executed by queryNullScalar.
+const queryNullScalar_Operation = `
+query queryNullScalar ($id: ID) {
+	user(id: $id) {
+		id
+		greatScalar
+	}
+}
+`
+
+func queryNullScalar(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*queryNullScalarResponse, map[string]interface{}, error) {
+	req_ := &graphql.Request{
+		OpName: "queryNullScalar",
+		Query:  queryNullScalar_Operation,
+		Variables: &__queryNullScalarInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ queryNullScalarResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, resp_.Extensions, err_
+}
+
 // The query or mutation executed by queryWithCustomMarshal.
 const queryWithCustomMarshal_Operation = `
 query queryWithCustomMarshal ($date: Date!) {
@@ -3616,6 +3944,46 @@ func queryWithOmitempty(
 	return &data_, resp_.Extensions, err_
 }
 
+// The query or mutation executed by queryWithOptionalFragment.
+const queryWithOptionalFragment_Operation = `
+query queryWithOptionalFragment ($includeFields: Boolean!) {
+	me {
+		id
+		... OptionalUserFields @include(if: $includeFields)
+	}
+}
+fragment OptionalUserFields on User {
+	name
+	luckyNumber
+}
+`
+
+func queryWithOptionalFragment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	includeFields bool,
+) (*queryWithOptionalFragmentResponse, map[string]interface{}, error) {
+	req_ := &graphql.Request{
+		OpName: "queryWithOptionalFragment",
+		Query:  queryWithOptionalFragment_Operation,
+		Variables: &__queryWithOptionalFragmentInput{
+			IncludeFields: includeFields,
+		},
+	}
+	var err_ error
+
+	var data_ queryWithOptionalFragmentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, resp_.Extensions, err_
+}
+
 // The query or mutation executed by queryWithVariables.
 const queryWithVariables_Operation = `
 query queryWithVariables ($id: ID!) {
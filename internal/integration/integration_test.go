@@ -37,6 +37,31 @@ func TestSimpleQuery(t *testing.T) {
 	}
 }
 
+func TestNullBoundScalar(t *testing.T) {
+	_ = `# @genqlient
+	query queryNullScalar($id: ID) { user(id: $id) { id greatScalar } }`
+
+	ctx := context.Background()
+	server := server.RunServer()
+	defer server.Close()
+	clients := newRoundtripClients(t, server.URL)
+
+	for _, client := range clients {
+		// MyGreatScalar.UnmarshalJSON rejects null, but genqlient.yaml sets
+		// skip_null, so the generated code doesn't call it.
+		resp, _, err := queryNullScalar(ctx, client, "2")
+		require.NoError(t, err)
+
+		assert.Equal(t, "2", resp.User.Id)
+		assert.Equal(t, MyGreatScalar(""), resp.User.GreatScalar)
+
+		resp, _, err = queryNullScalar(ctx, client, "1")
+		require.NoError(t, err)
+
+		assert.Equal(t, MyGreatScalar("cool value"), resp.User.GreatScalar)
+	}
+}
+
 func TestMutation(t *testing.T) {
 	_ = `# @genqlient
 	mutation createUser($user: NewUser!) { createUser(input: $user) { id name } }`
@@ -731,6 +756,42 @@ func TestFlatten(t *testing.T) {
 	}
 }
 
+func TestOptionalFragment(t *testing.T) {
+	_ = `# @genqlient
+	fragment OptionalUserFields on User { name luckyNumber }
+
+	query queryWithOptionalFragment {
+		me {
+			id
+			# @genqlient(optionalFragment: "includeFields")
+			...OptionalUserFields
+		}
+	}`
+
+	ctx := context.Background()
+	server := server.RunServer()
+	defer server.Close()
+	clients := newRoundtripClients(t, server.URL)
+
+	for _, client := range clients {
+		resp, _, err := queryWithOptionalFragment(ctx, client, true)
+		require.NoError(t, err)
+		assert.Equal(t, "1", resp.Me.Id)
+		require.NotNil(t, resp.Me.OptionalUserFields)
+		assert.Equal(t, "Yours Truly", resp.Me.Name)
+		assert.Equal(t, "Yours Truly", resp.Me.GetName())
+		assert.Equal(t, 17, resp.Me.GetLuckyNumber())
+
+		resp, _, err = queryWithOptionalFragment(ctx, client, false)
+		require.NoError(t, err)
+		assert.Equal(t, "1", resp.Me.Id)
+		assert.Nil(t, resp.Me.OptionalUserFields)
+		// The getters don't panic, but return the zero value.
+		assert.Equal(t, "", resp.Me.GetName())
+		assert.Equal(t, 0, resp.Me.GetLuckyNumber())
+	}
+}
+
 func TestGeneratedCode(t *testing.T) {
 	// TODO(benkraft): Check that gqlgen is up to date too.  In practice that's
 	// less likely to be a problem, since it should only change if you update
@@ -21,7 +21,7 @@ var users = []*User{
 		Hair:        &Hair{Color: strptr("Black")},
 		GreatScalar: strptr("cool value"),
 	},
-	{ID: "2", Name: "Raven", LuckyNumber: intptr(-1), Hair: nil, GreatScalar: strptr("cool value")},
+	{ID: "2", Name: "Raven", LuckyNumber: intptr(-1), Hair: nil, GreatScalar: nil},
 }
 
 func init() {
@@ -0,0 +1,64 @@
+// Package sharedfragments contains integration tests for fragments_package,
+// whereby the types for named fragments are generated into (and imported
+// from) a separate package, here the fragments subpackage.
+package sharedfragments
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/Khan/genqlient/internal/integration"
+	"github.com/Khan/genqlient/internal/integration/server"
+	"github.com/Khan/genqlient/internal/integration/sharedfragments/fragments"
+)
+
+func TestSharedFragments(t *testing.T) {
+	_ = `# @genqlient
+	query queryWithSharedFragments($id: ID!) {
+		user(id: $id) { ...SharedUserFields luckyNumber }
+		being(id: $id) { ...SharedBeingFields }
+	}`
+
+	ctx := context.Background()
+	server := server.RunServer()
+	defer server.Close()
+	client := graphql.NewClient(server.URL, http.DefaultClient)
+
+	resp, err := queryWithSharedFragments(ctx, client, "1")
+	require.NoError(t, err)
+
+	var userFields fragments.SharedUserFields = resp.User.SharedUserFields
+	assert.Equal(t, "1", userFields.Id)
+	assert.Equal(t, "Yours Truly", resp.User.Name)
+	assert.Equal(t, 17, resp.User.LuckyNumber)
+
+	being, ok := resp.Being.(*queryWithSharedFragmentsBeingUser)
+	require.Truef(t, ok, "got %T, not User", resp.Being)
+	assert.Equal(t, "Yours Truly", being.Name)
+
+	resp, err = queryWithSharedFragments(ctx, client, "3")
+	require.NoError(t, err)
+
+	animal, ok := resp.Being.(*queryWithSharedFragmentsBeingAnimal)
+	require.Truef(t, ok, "got %T, not Animal", resp.Being)
+	assert.Equal(t, fragments.SpeciesDog, animal.Species)
+
+	var beingFields fragments.SharedBeingFields = resp.Being
+	assert.Equal(t, "3", beingFields.GetId())
+}
+
+func TestGeneratedCode(t *testing.T) {
+	// Make sure that generated.go is up to date in both packages.
+	integration.RunGenerateTest(t,
+		"internal/integration/sharedfragments/fragments/genqlient.yaml")
+	integration.RunGenerateTest(t,
+		"internal/integration/sharedfragments/genqlient.yaml")
+}
+
+//go:generate go run github.com/Khan/genqlient fragments/genqlient.yaml
+//go:generate go run github.com/Khan/genqlient genqlient.yaml
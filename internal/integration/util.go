@@ -2,6 +2,7 @@ package integration
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -68,3 +69,22 @@ func RunGenerateTest(t *testing.T, relConfigFilename string) {
 // This is here rather than in testutil to test the case where the generated
 // code and the bound type are in the same package.
 type MyGreatScalar string
+
+// UnmarshalJSON unmarshals a MyGreatScalar, which (like many types with
+// custom unmarshalers) can't be null; genqlient.yaml sets skip_null so that
+// a null greatScalar leaves the zero value.
+func (s *MyGreatScalar) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return fmt.Errorf("MyGreatScalar can't be null")
+	}
+	return json.Unmarshal(b, (*string)(s))
+}
+
+// MarshalJSON marshals a MyGreatScalar, with the zero value as null, so that
+// it round-trips.
+func (s MyGreatScalar) MarshalJSON() ([]byte, error) {
+	if s == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(string(s))
+}
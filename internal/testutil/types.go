@@ -3,6 +3,7 @@ package testutil
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
@@ -19,16 +20,31 @@ func (p Pokemon) Battle(q Pokemon) bool {
 	return p.Level > q.Level
 }
 
+func FormatPokemon(p Pokemon) string {
+	return fmt.Sprintf("%s (level %d)", p.Species, p.Level)
+}
+
 type MyContext interface {
 	context.Context
 
 	MyMethod()
 }
 
+// MyAdaptedContext is a context type which doesn't implement context.Context,
+// for use with the context_adapter option.
+type MyAdaptedContext struct{ Ctx context.Context }
+
+func ContextFromMyAdaptedContext(ctx *MyAdaptedContext) context.Context { return ctx.Ctx }
+
 func GetClientFromNowhere() (graphql.Client, error)                    { return nil, nil }
 func GetClientFromContext(ctx context.Context) (graphql.Client, error) { return nil, nil }
 func GetClientFromMyContext(ctx MyContext) (graphql.Client, error)     { return nil, nil }
 
+type ResponseWithRequestID struct {
+	graphql.Response
+	RequestID string `json:"requestId"`
+}
+
 const dateFormat = "2006-01-02"
 
 func MarshalDate(t *time.Time) ([]byte, error) {